@@ -13,7 +13,20 @@ import (
 
 	// Handlers unificados (todos os tools em um único arquivo)
 	"github.com/fmendonca/openshift-mcp/internal/handlers"
+	"github.com/fmendonca/openshift-mcp/internal/tools/builds"
+	"github.com/fmendonca/openshift-mcp/internal/tools/clusterhealth"
+	"github.com/fmendonca/openshift-mcp/internal/tools/daemonsets"
+	"github.com/fmendonca/openshift-mcp/internal/tools/deploymentconfigs"
+	"github.com/fmendonca/openshift-mcp/internal/tools/deployments"
+	"github.com/fmendonca/openshift-mcp/internal/tools/hpa"
+	"github.com/fmendonca/openshift-mcp/internal/tools/jobs"
+	"github.com/fmendonca/openshift-mcp/internal/tools/replicasets"
+	"github.com/fmendonca/openshift-mcp/internal/tools/statefulsets"
+	"github.com/fmendonca/openshift-mcp/internal/tools/templates"
+	"github.com/fmendonca/openshift-mcp/internal/tools/workloads"
+
 	// Resources (cluster e namespaces)
+	clusterres "github.com/fmendonca/openshift-mcp/internal/resources/cluster"
 )
 
 func main() {
@@ -42,14 +55,48 @@ func main() {
 	// Registra TODOS os tools em um único lugar (pods, services, etc.)
 	handlers.RegisterAllTools(srv.Inner(), k8sClients)
 
+	// Deployments (list/get/scale/restart e rollout)
+	deployments.RegisterTools(srv, k8sClients)
+
+	// DeploymentConfigs (apps.openshift.io)
+	deploymentconfigs.RegisterTools(srv, k8sClients)
+
+	// BuildConfigs e Builds (build.openshift.io)
+	builds.RegisterTools(srv, k8sClients)
+
+	// StatefulSets, DaemonSets e ReplicaSets
+	statefulsets.RegisterTools(srv, k8sClients)
+	daemonsets.RegisterTools(srv, k8sClients)
+	replicasets.RegisterTools(srv, k8sClients)
+
+	// set_image / set_env / set_resources no pod template dos workloads
+	workloads.RegisterTools(srv, k8sClients)
+
+	// Jobs e CronJobs
+	jobs.RegisterTools(srv, k8sClients)
+
+	// HorizontalPodAutoscalers
+	hpa.RegisterTools(srv, k8sClients)
+
+	// Templates do OpenShift (list, describe, process / oc new-app)
+	templates.RegisterTools(srv, k8sClients)
+
+	// Saúde do cluster (ClusterVersion e ClusterOperators)
+	clusterhealth.RegisterTools(srv, k8sClients)
+
 	// Registra resources (cluster://..., namespaces://...)
-	//clusterres.RegisterResources(srv, k8sClients)
+	clusterres.RegisterResources(srv.Inner(), k8sClients)
 	//namespaceres.RegisterResources(srv, k8sClients)
 
 	log.Println("Starting OpenShift/Kubernetes MCP server over stdio...")
 
 	// Inicia o servidor usando transporte stdio (Claude, VS Code, etc.)
-	if err := srv.Start(ctx); err != nil {
+	err = srv.Start(ctx)
+
+	// Encerra port-forwards e outras sessões mantidas pelos handlers
+	handlers.Shutdown()
+
+	if err != nil {
 		log.Fatalf("MCP server error: %v", err)
 	}
 
@@ -3,8 +3,11 @@ package clients
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
 
 	"k8s.io/client-go/rest"
 )
@@ -13,6 +16,7 @@ type Clients struct {
 	Kubernetes *kubernetes.Clientset
 	Dynamic    dynamic.Interface
 	RestConfig *rest.Config
+	Mapper     meta.RESTMapper
 }
 
 func NewClients() (*Clients, error) {
@@ -31,9 +35,15 @@ func NewClients() (*Clients, error) {
 		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
 	}
 
+	// RESTMapper com cache de discovery e short names (deploy, svc, ...);
+	// meta.MaybeResetRESTMapper força nova descoberta (ex.: CRDs recém-criados)
+	cached := memory.NewMemCacheClient(kube.Discovery())
+	mapper := restmapper.NewShortcutExpander(restmapper.NewDeferredDiscoveryRESTMapper(cached), cached, nil)
+
 	return &Clients{
 		Kubernetes: kube,
 		Dynamic:    dyn,
 		RestConfig: cfg,
+		Mapper:     mapper,
 	}, nil
 }
@@ -0,0 +1,270 @@
+// Package clusterstatus lê o estado de um cluster OpenShift 4 (ClusterVersion e
+// ClusterOperators em config.openshift.io), compartilhado entre tools e resources.
+package clusterstatus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	clusterVersionsGVR  = schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "clusterversions"}
+	clusterOperatorsGVR = schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "clusteroperators"}
+)
+
+const (
+	// clusterVersionName é o singleton ClusterVersion mantido pelo CVO.
+	clusterVersionName = "version"
+
+	// clusterVersionFailing não tem constante em openshift/api.
+	clusterVersionFailing configv1.ClusterStatusConditionType = "Failing"
+)
+
+// Report é o retrato de saúde do cluster usado pelo tool cluster_health e pelo
+// resource cluster://openshift/operators.
+type Report struct {
+	OpenShift bool           `json:"openshift"`
+	Version   *VersionInfo   `json:"clusterVersion,omitempty"`
+	Operators []OperatorInfo `json:"operators"`
+	Degraded  []string       `json:"degraded"`
+	Available int            `json:"available"`
+}
+
+type VersionInfo struct {
+	ClusterID          string            `json:"clusterID"`
+	Channel            string            `json:"channel,omitempty"`
+	Current            string            `json:"current,omitempty"`
+	Desired            string            `json:"desired"`
+	DesiredImage       string            `json:"desiredImage,omitempty"`
+	Conditions         []Condition       `json:"conditions,omitempty"`
+	History            []HistoryEntry    `json:"history,omitempty"`
+	AvailableUpdates   []string          `json:"availableUpdates,omitempty"`
+	ConditionalUpdates []ConditionalInfo `json:"conditionalUpdates,omitempty"`
+}
+
+type Condition struct {
+	Type    string    `json:"type"`
+	Status  string    `json:"status"`
+	Reason  string    `json:"reason,omitempty"`
+	Message string    `json:"message,omitempty"`
+	Since   time.Time `json:"since"`
+}
+
+type HistoryEntry struct {
+	Version   string     `json:"version"`
+	State     string     `json:"state"`
+	Started   time.Time  `json:"started"`
+	Completed *time.Time `json:"completed,omitempty"`
+	Verified  bool       `json:"verified"`
+}
+
+// ConditionalInfo é uma atualização que o OSUS só recomenda com riscos conhecidos.
+type ConditionalInfo struct {
+	Version string   `json:"version"`
+	Risks   []string `json:"risks"`
+}
+
+type OperatorInfo struct {
+	Name        string     `json:"name"`
+	Version     string     `json:"version,omitempty"`
+	Available   string     `json:"available"`
+	Progressing string     `json:"progressing"`
+	Degraded    string     `json:"degraded"`
+	Upgradeable string     `json:"upgradeable,omitempty"`
+	Message     string     `json:"message,omitempty"`
+	Since       *time.Time `json:"since,omitempty"`
+}
+
+// Healthy: Available=True e Degraded diferente de True.
+func (o OperatorInfo) Healthy() bool {
+	return o.Available == string(configv1.ConditionTrue) && o.Degraded != string(configv1.ConditionTrue)
+}
+
+// Collect lê o ClusterVersion e todos os ClusterOperators. Em clusters sem
+// config.openshift.io devolve um Report com OpenShift=false em vez de erro.
+func Collect(ctx context.Context, c *clients.Clients) (*Report, error) {
+	report := &Report{OpenShift: true, Operators: []OperatorInfo{}, Degraded: []string{}}
+
+	version, err := GetVersion(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		report.OpenShift = false
+		return report, nil
+	}
+	report.Version = version
+
+	ul, err := c.Dynamic.Resource(clusterOperatorsGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusteroperators: %w", err)
+	}
+	list := &configv1.ClusterOperatorList{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(ul.UnstructuredContent(), list); err != nil {
+		return nil, fmt.Errorf("failed to decode clusteroperators: %w", err)
+	}
+
+	for i := range list.Items {
+		op := operatorInfo(&list.Items[i])
+		if op.Available == string(configv1.ConditionTrue) {
+			report.Available++
+		}
+		if !op.Healthy() {
+			report.Degraded = append(report.Degraded, op.Name)
+		}
+		report.Operators = append(report.Operators, op)
+	}
+	// Os problemáticos primeiro, depois por nome
+	sort.SliceStable(report.Operators, func(i, j int) bool {
+		hi, hj := report.Operators[i].Healthy(), report.Operators[j].Healthy()
+		if hi != hj {
+			return !hi
+		}
+		return report.Operators[i].Name < report.Operators[j].Name
+	})
+	sort.Strings(report.Degraded)
+
+	return report, nil
+}
+
+// GetVersion lê só o ClusterVersion; devolve nil (sem erro) em clusters sem config.openshift.io.
+func GetVersion(ctx context.Context, c *clients.Clients) (*VersionInfo, error) {
+	u, err := c.Dynamic.Resource(clusterVersionsGVR).Get(ctx, clusterVersionName, metav1.GetOptions{})
+	switch {
+	case notOpenShift(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get clusterversion: %w", err)
+	}
+	cv := &configv1.ClusterVersion{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cv); err != nil {
+		return nil, fmt.Errorf("failed to decode clusterversion: %w", err)
+	}
+	return versionInfo(cv), nil
+}
+
+func notOpenShift(err error) bool {
+	return err != nil && (apierrors.IsNotFound(err) || meta.IsNoMatchError(err))
+}
+
+func versionInfo(cv *configv1.ClusterVersion) *VersionInfo {
+	info := &VersionInfo{
+		ClusterID:    string(cv.Spec.ClusterID),
+		Channel:      cv.Spec.Channel,
+		Desired:      cv.Status.Desired.Version,
+		DesiredImage: cv.Status.Desired.Image,
+	}
+
+	// A versão atual é a última entrada Completed do histórico (o mais recente vem primeiro)
+	for _, h := range cv.Status.History {
+		if h.State == configv1.CompletedUpdate {
+			info.Current = h.Version
+			break
+		}
+	}
+
+	for _, t := range []configv1.ClusterStatusConditionType{
+		configv1.OperatorAvailable,
+		configv1.OperatorProgressing,
+		clusterVersionFailing,
+		configv1.OperatorUpgradeable,
+		configv1.RetrievedUpdates,
+	} {
+		if cond := findCondition(cv.Status.Conditions, t); cond != nil {
+			info.Conditions = append(info.Conditions, Condition{
+				Type:    string(cond.Type),
+				Status:  string(cond.Status),
+				Reason:  cond.Reason,
+				Message: cond.Message,
+				Since:   cond.LastTransitionTime.Time,
+			})
+		}
+	}
+
+	for _, h := range cv.Status.History {
+		entry := HistoryEntry{
+			Version:  h.Version,
+			State:    string(h.State),
+			Started:  h.StartedTime.Time,
+			Verified: h.Verified,
+		}
+		if h.CompletionTime != nil {
+			t := h.CompletionTime.Time
+			entry.Completed = &t
+		}
+		info.History = append(info.History, entry)
+	}
+
+	for _, r := range cv.Status.AvailableUpdates {
+		info.AvailableUpdates = append(info.AvailableUpdates, r.Version)
+	}
+	for _, cu := range cv.Status.ConditionalUpdates {
+		ci := ConditionalInfo{Version: cu.Release.Version}
+		for _, risk := range cu.Risks {
+			ci.Risks = append(ci.Risks, risk.Name)
+		}
+		info.ConditionalUpdates = append(info.ConditionalUpdates, ci)
+	}
+
+	return info
+}
+
+func operatorInfo(co *configv1.ClusterOperator) OperatorInfo {
+	op := OperatorInfo{
+		Name:        co.Name,
+		Available:   conditionStatus(co.Status.Conditions, configv1.OperatorAvailable),
+		Progressing: conditionStatus(co.Status.Conditions, configv1.OperatorProgressing),
+		Degraded:    conditionStatus(co.Status.Conditions, configv1.OperatorDegraded),
+	}
+	if cond := findCondition(co.Status.Conditions, configv1.OperatorUpgradeable); cond != nil {
+		op.Upgradeable = string(cond.Status)
+	}
+	for _, v := range co.Status.Versions {
+		if v.Name == "operator" {
+			op.Version = v.Version
+			break
+		}
+	}
+
+	// Mensagem da condição que explica o problema: Degraded=True, senão Available!=True
+	var cond *configv1.ClusterOperatorStatusCondition
+	if op.Degraded == string(configv1.ConditionTrue) {
+		cond = findCondition(co.Status.Conditions, configv1.OperatorDegraded)
+	} else if op.Available != string(configv1.ConditionTrue) {
+		cond = findCondition(co.Status.Conditions, configv1.OperatorAvailable)
+	}
+	if cond != nil {
+		op.Message = cond.Message
+		t := cond.LastTransitionTime.Time
+		op.Since = &t
+	}
+
+	return op
+}
+
+func findCondition(conds []configv1.ClusterOperatorStatusCondition, t configv1.ClusterStatusConditionType) *configv1.ClusterOperatorStatusCondition {
+	for i := range conds {
+		if conds[i].Type == t {
+			return &conds[i]
+		}
+	}
+	return nil
+}
+
+func conditionStatus(conds []configv1.ClusterOperatorStatusCondition, t configv1.ClusterStatusConditionType) string {
+	if cond := findCondition(conds, t); cond != nil {
+		return string(cond.Status)
+	}
+	return string(configv1.ConditionUnknown)
+}
@@ -0,0 +1,117 @@
+// internal/handlers/audit.go
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// AUDIT LOG (JSON lines em MCP_AUDIT_LOG ou no stderr)
+///////////////////////////////////////////////////////////////////////////////
+
+// auditEvent é um registro do audit log.
+type auditEvent struct {
+	Time      string   `json:"time"`
+	Tool      string   `json:"tool"`
+	Decision  string   `json:"decision"` // allowed | denied
+	Reason    string   `json:"reason,omitempty"`
+	User      string   `json:"user"`
+	Session   string   `json:"session,omitempty"`
+	Client    string   `json:"client,omitempty"`
+	Namespace string   `json:"namespace,omitempty"`
+	Pod       string   `json:"pod,omitempty"`
+	Container string   `json:"container,omitempty"`
+	Command   []string `json:"command,omitempty"`
+	ExitCode  *int     `json:"exitCode,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+var (
+	auditMu     sync.Mutex
+	auditOut    io.Writer
+	auditOnce   sync.Once
+	kubeUser    string
+	kubeUserErr error
+	userOnce    sync.Once
+)
+
+// writeAudit grava o evento preenchendo horário e identidade do chamador.
+func writeAudit(ctx context.Context, c *clients.Clients, ev auditEvent) {
+	ev.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	ev.User = kubeIdentity(c)
+	if session := mcpsrv.ClientSessionFromContext(ctx); session != nil {
+		ev.Session = session.SessionID()
+		if withInfo, ok := session.(mcpsrv.SessionWithClientInfo); ok {
+			info := withInfo.GetClientInfo()
+			if info.Name != "" {
+				ev.Client = info.Name + "/" + info.Version
+			}
+		}
+	}
+
+	data, err := json.Marshal(ev)
+	if err != nil {
+		log.Printf("Failed to encode audit event: %v", err)
+		return
+	}
+
+	auditMu.Lock()
+	defer auditMu.Unlock()
+	if _, err := auditWriter().Write(append(data, '\n')); err != nil {
+		log.Printf("Failed to write audit event: %v", err)
+	}
+}
+
+// auditWriter abre MCP_AUDIT_LOG (append) na primeira utilização; sem a variável usa o stderr.
+func auditWriter() io.Writer {
+	auditOnce.Do(func() {
+		auditOut = os.Stderr
+		path := os.Getenv("MCP_AUDIT_LOG")
+		if path == "" {
+			return
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			log.Printf("Failed to open audit log %s, falling back to stderr: %v", path, err)
+			return
+		}
+		auditOut = f
+	})
+	return auditOut
+}
+
+// kubeIdentity descobre (uma vez) o usuário Kubernetes usado pelo servidor via SelfSubjectReview.
+func kubeIdentity(c *clients.Clients) string {
+	userOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		review, err := c.Kubernetes.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authnv1.SelfSubjectReview{}, metav1.CreateOptions{})
+		if err != nil {
+			kubeUserErr = err
+			return
+		}
+		kubeUser = review.Status.UserInfo.Username
+	})
+	if kubeUser != "" {
+		return kubeUser
+	}
+	if c.RestConfig.Username != "" {
+		return c.RestConfig.Username
+	}
+	if kubeUserErr != nil {
+		return "unknown (" + kubeUserErr.Error() + ")"
+	}
+	return "unknown"
+}
@@ -0,0 +1,300 @@
+// internal/handlers/copy.go
+package handlers
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// COPY (tar via exec, como o kubectl cp)
+///////////////////////////////////////////////////////////////////////////////
+
+const (
+	defaultCopyBytes = 1024 * 1024
+	maxCopyBytes     = 50 * 1024 * 1024
+	copyTimeout      = 5 * time.Minute
+)
+
+func registerCopyTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	fromTool := mcp.NewTool(
+		"copy_from_pod",
+		mcp.WithDescription("Copy a file out of a pod container (tar over exec; requires tar in the image). Args: name (string), namespace (string), container (string, optional), path (string, absolute file path), maxBytes (int, optional, default 1MiB, max 50MiB), encoding (string, optional: auto|text|base64, default auto), asResource (bool, optional, return the file as an embedded MCP resource)."),
+	)
+	srv.AddTool(fromTool, copyFromPodHandler(c))
+
+	toTool := mcp.NewTool(
+		"copy_to_pod",
+		mcp.WithDescription("Write a file into a pod container (tar over exec; requires tar in the image). Args: name (string), namespace (string), container (string, optional), path (string, absolute destination file path), content (string), encoding (string, optional: text|base64, default text), mode (string, optional, octal, default 0644)."),
+	)
+	srv.AddTool(toTool, copyToPodHandler(c))
+}
+
+func copyFromPodHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		container := utils.GetStringArg(args, "container", "")
+		filePath, err := cleanPodPath(utils.GetStringArg(args, "path", ""))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		maxBytes := utils.GetIntArg(args, "maxBytes", defaultCopyBytes)
+		if maxBytes < 1 || maxBytes > maxCopyBytes {
+			return mcp.NewToolResultError(fmt.Sprintf("maxBytes must be between 1 and %d", maxCopyBytes)), nil
+		}
+		encoding := strings.ToLower(utils.GetStringArg(args, "encoding", "auto"))
+		if encoding != "auto" && encoding != "text" && encoding != "base64" {
+			return mcp.NewToolResultError("encoding must be auto, text or base64"), nil
+		}
+
+		data, err := readFileFromPod(ctx, c, ns, name, container, filePath, int64(maxBytes))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to copy %s from pod %s/%s: %v", filePath, ns, name, err)), nil
+		}
+
+		isText := utf8.Valid(data)
+		if encoding == "text" && !isText {
+			return mcp.NewToolResultError(fmt.Sprintf("%s is not valid UTF-8 text; use encoding=base64", filePath)), nil
+		}
+		asText := encoding == "text" || (encoding == "auto" && isText)
+
+		summary := fmt.Sprintf("Copied %s from pod %s/%s (%d bytes)", filePath, ns, name, len(data))
+		if utils.GetBoolArg(args, "asResource", false) {
+			uri := fmt.Sprintf("pod://%s/%s/%s%s", ns, name, container, filePath)
+			mimeType := fileMIMEType(filePath, data)
+			if asText {
+				return mcp.NewToolResultResource(summary, mcp.TextResourceContents{
+					URI:      uri,
+					MIMEType: mimeType,
+					Text:     string(data),
+				}), nil
+			}
+			return mcp.NewToolResultResource(summary, mcp.BlobResourceContents{
+				URI:      uri,
+				MIMEType: mimeType,
+				Blob:     base64.StdEncoding.EncodeToString(data),
+			}), nil
+		}
+
+		if asText {
+			return mcp.NewToolResultText(summary + ":\n\n" + string(data)), nil
+		}
+		return mcp.NewToolResultText(summary + ", base64:\n\n" + base64.StdEncoding.EncodeToString(data)), nil
+	}
+}
+
+func copyToPodHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		container := utils.GetStringArg(args, "container", "")
+		filePath, err := cleanPodPath(utils.GetStringArg(args, "path", ""))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		content, ok := args["content"].(string)
+		if !ok {
+			return mcp.NewToolResultError("content is required"), nil
+		}
+		data := []byte(content)
+		switch strings.ToLower(utils.GetStringArg(args, "encoding", "text")) {
+		case "text":
+		case "base64":
+			if data, err = base64.StdEncoding.DecodeString(content); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid base64 content: %v", err)), nil
+			}
+		default:
+			return mcp.NewToolResultError("encoding must be text or base64"), nil
+		}
+		if len(data) > maxCopyBytes {
+			return mcp.NewToolResultError(fmt.Sprintf("content exceeds %d bytes", maxCopyBytes)), nil
+		}
+
+		mode, err := strconv.ParseInt(utils.GetStringArg(args, "mode", "0644"), 8, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid mode: %v", err)), nil
+		}
+
+		if err := writeFileToPod(ctx, c, ns, name, container, filePath, data, mode); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to copy to %s in pod %s/%s: %v", filePath, ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Wrote %d bytes to %s in pod %s/%s (mode %04o)", len(data), filePath, ns, name, mode)), nil
+	}
+}
+
+// readFileFromPod executa "tar cf - -C <dir> -- <arquivo>" no container e extrai o único arquivo do stream,
+// abortando a exec assim que o tamanho passa de maxBytes.
+func readFileFromPod(ctx context.Context, c *clients.Clients, ns, pod, container, filePath string, maxBytes int64) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, copyTimeout)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	stderr := &cappedBuffer{max: 4096}
+	done := make(chan error, 1)
+	go func() {
+		exitCode, err := execInPod(ctx, c, execRequest{
+			Tool:      "copy_from_pod",
+			Namespace: ns,
+			Pod:       pod,
+			Container: container,
+			Command:   []string{"tar", "cf", "-", "-C", path.Dir(filePath), "--", path.Base(filePath)},
+			Stdout:    pw,
+			Stderr:    stderr,
+		})
+		if err == nil && exitCode != 0 {
+			err = fmt.Errorf("tar exited with code %d: %s", exitCode, strings.TrimSpace(stderr.String()))
+		}
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	data, readErr := readSingleTarFile(pr, maxBytes)
+	if readErr == nil {
+		// só resta o padding/trailer do tar
+		_, _ = io.Copy(io.Discard, pr)
+	} else {
+		// interrompe a exec (ex.: arquivo grande demais)
+		cancel()
+	}
+	pr.Close()
+	execErr := <-done
+
+	if readErr != nil {
+		if (errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF)) && execErr != nil {
+			return nil, execErr
+		}
+		return nil, readErr
+	}
+	if execErr != nil {
+		return nil, execErr
+	}
+	return data, nil
+}
+
+func readSingleTarFile(r io.Reader, maxBytes int64) ([]byte, error) {
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, err
+	}
+	switch hdr.Typeflag {
+	case tar.TypeReg:
+	case tar.TypeDir:
+		return nil, fmt.Errorf("%s is a directory", hdr.Name)
+	case tar.TypeSymlink:
+		return nil, fmt.Errorf("%s is a symlink to %s; copy the target instead", hdr.Name, hdr.Linkname)
+	default:
+		return nil, fmt.Errorf("%s is not a regular file", hdr.Name)
+	}
+	if hdr.Size > maxBytes {
+		return nil, fmt.Errorf("file is %d bytes, larger than maxBytes=%d", hdr.Size, maxBytes)
+	}
+
+	return io.ReadAll(io.LimitReader(tr, maxBytes))
+}
+
+// writeFileToPod envia um tar com um único arquivo para "tar xf -" no diretório de destino.
+func writeFileToPod(ctx context.Context, c *clients.Clients, ns, pod, container, filePath string, data []byte, mode int64) error {
+	ctx, cancel := context.WithTimeout(ctx, copyTimeout)
+	defer cancel()
+
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    path.Base(filePath),
+		Mode:    mode,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	stdout := &cappedBuffer{max: 4096}
+	stderr := &cappedBuffer{max: 4096}
+	exitCode, err := execInPod(ctx, c, execRequest{
+		Tool:      "copy_to_pod",
+		Namespace: ns,
+		Pod:       pod,
+		Container: container,
+		Command:   []string{"tar", "xmf", "-", "-C", path.Dir(filePath), "--"},
+		Stdin:     &archive,
+		Stdout:    stdout,
+		Stderr:    stderr,
+	})
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("tar exited with code %d: %s", exitCode, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+func cleanPodPath(p string) (string, error) {
+	if p == "" {
+		return "", fmt.Errorf("path is required")
+	}
+	if !strings.HasPrefix(p, "/") {
+		return "", fmt.Errorf("path must be absolute")
+	}
+	p = path.Clean(p)
+	if p == "/" {
+		return "", fmt.Errorf("path must point to a file")
+	}
+	// o nome vai como argumento do tar (depois de "--"); ainda assim recusa o que pareceria opção
+	if base := path.Base(p); base == "" || base == "." || strings.HasPrefix(base, "-") {
+		return "", fmt.Errorf("invalid file name %q", base)
+	}
+	return p, nil
+}
+
+func fileMIMEType(filePath string, data []byte) string {
+	if t := mime.TypeByExtension(path.Ext(filePath)); t != "" {
+		return t
+	}
+	return http.DetectContentType(data)
+}
@@ -0,0 +1,400 @@
+// internal/handlers/debug.go
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/kube"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// DEBUG (ephemeral containers e pods de debug em nodes)
+///////////////////////////////////////////////////////////////////////////////
+
+const (
+	fallbackDebugImage      = "busybox:1.36"
+	defaultDebugWaitSeconds = 120
+	maxDebugWaitSeconds     = 600
+	// defaultDebugKeepAlive é quanto tempo o container de debug fica de pé esperando novas execs;
+	// maxDebugKeepAlive limita o tempo de vida de containers e pods de debug (privilegiados no node).
+	defaultDebugKeepAlive = 3600
+	maxDebugKeepAlive     = 3600
+	debugPollInterval     = 2 * time.Second
+)
+
+// debugImage devolve a imagem padrão de debug (MCP_DEBUG_IMAGE ou busybox).
+func debugImage() string {
+	if img := os.Getenv("MCP_DEBUG_IMAGE"); img != "" {
+		return img
+	}
+	return fallbackDebugImage
+}
+
+func registerDebugTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	podTool := mcp.NewTool(
+		"debug_pod",
+		mcp.WithDescription("Debug a running pod (including distroless ones) by adding an ephemeral container and running a command in it. Ephemeral containers cannot be removed; the container keeps running for keepAliveSeconds and can be reused by passing its name. Args: name (string), namespace (string), command ([]string, or string when shell=true), shell (bool, optional), image (string, optional, default MCP_DEBUG_IMAGE or busybox:1.36), targetContainer (string, optional, share this container's process namespace), containerName (string, optional, reuse or name the debug container), keepAliveSeconds (int, optional, default and max 3600), waitSeconds (int, optional, default 120), timeoutSeconds (int, optional, command timeout, default 60), maxOutputBytes (int, optional)."),
+	)
+	srv.AddTool(podTool, debugPodHandler(c))
+
+	nodeTool := mcp.NewTool(
+		"debug_node",
+		mcp.WithDescription("Debug a node: start a privileged pod with host PID/network/IPC namespaces and the node root filesystem mounted at /host, run a command and delete the pod. Use \"chroot /host <cmd>\" to run node binaries. The namespace must allow privileged pods and the exec policy must set allowDebugNode. Args: node (string), namespace (string, optional, default \"default\"), command ([]string, or string when shell=true), shell (bool, optional), image (string, optional), waitSeconds (int, optional, default 120), timeoutSeconds (int, optional, default 60), keepPod (bool, optional, keep the pod for further exec_pod calls; it is deleted on any error), keepAliveSeconds (int, optional, lifetime of the pod, default and max 3600), maxOutputBytes (int, optional)."),
+	)
+	srv.AddTool(nodeTool, debugNodeHandler(c))
+}
+
+func debugPodHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		command, err := execCommandFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts, err := debugExecOptionsFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		keepAlive, err := debugKeepAliveFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// A política é checada antes do UpdateEphemeralContainers: o container adicionado não pode ser removido
+		probe := execRequest{Tool: "debug_pod", Namespace: ns, Pod: name, Container: utils.GetStringArg(args, "containerName", ""), Command: command}
+		if err := denyExec(ctx, c, probe, activeExecPolicy.check(ns, command)); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		pod, err := c.Kubernetes.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod %s/%s: %v", ns, name, err)), nil
+		}
+		if pod.Status.Phase != corev1.PodRunning {
+			return mcp.NewToolResultError(fmt.Sprintf("pod %s/%s is %s; ephemeral containers need a running pod", ns, name, pod.Status.Phase)), nil
+		}
+
+		target := utils.GetStringArg(args, "targetContainer", "")
+		if target != "" && !podHasContainer(pod, target) {
+			return mcp.NewToolResultError(fmt.Sprintf("container %s not found in pod %s/%s", target, ns, name)), nil
+		}
+
+		debugName := utils.GetStringArg(args, "containerName", "")
+		var notes []string
+		if debugName != "" && ephemeralContainerRunning(pod, debugName) {
+			notes = append(notes, fmt.Sprintf("Reusing running ephemeral container %s.", debugName))
+		} else {
+			if debugName == "" {
+				debugName = "debugger-" + utilrand.String(5)
+			} else if podHasContainer(pod, debugName) || hasEphemeralContainer(pod, debugName) {
+				return mcp.NewToolResultError(fmt.Sprintf("container %s already exists in pod %s/%s and is not running; choose another containerName", debugName, ns, name)), nil
+			}
+
+			pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+				EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+					Name:                     debugName,
+					Image:                    utils.GetStringArg(args, "image", debugImage()),
+					Command:                  []string{"sleep", fmt.Sprint(keepAlive)},
+					ImagePullPolicy:          corev1.PullIfNotPresent,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+					Stdin:                    true,
+				},
+				TargetContainerName: target,
+			})
+			if _, err := c.Kubernetes.CoreV1().Pods(ns).UpdateEphemeralContainers(ctx, name, pod, metav1.UpdateOptions{}); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to add ephemeral container to pod %s/%s: %v", ns, name, err)), nil
+			}
+			if err := waitForContainerRunning(ctx, c, ns, name, debugName, opts.wait); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Ephemeral container %s in pod %s/%s did not start: %v", debugName, ns, name, err)), nil
+			}
+			notes = append(notes, fmt.Sprintf("Started ephemeral container %s (kept alive for %ds; pass containerName=%s to reuse it).", debugName, keepAlive, debugName))
+		}
+
+		output, err := runDebugCommand(ctx, c, "debug_pod", ns, name, debugName, command, opts)
+		if err != nil {
+			return mcp.NewToolResultError(strings.Join(append(notes, err.Error()), "\n")), nil
+		}
+		return mcp.NewToolResultText(strings.Join(notes, "\n") + "\n\n" + output), nil
+	}
+}
+
+func debugNodeHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		nodeName := utils.GetStringArg(args, "node", "")
+		if nodeName == "" {
+			return mcp.NewToolResultError("node is required"), nil
+		}
+		ns := utils.GetStringArg(args, "namespace", "default")
+		command, err := execCommandFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts, err := debugExecOptionsFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		keepPod := utils.GetBoolArg(args, "keepPod", false)
+		keepAlive, err := debugKeepAliveFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// Antes de criar o pod privilegiado: debug_node precisa estar liberado e o comando permitido
+		probe := execRequest{Tool: "debug_node", Namespace: ns, Pod: "node/" + nodeName, Container: "debugger", Command: command}
+		reason := activeExecPolicy.checkDebugNode()
+		if reason == "" {
+			reason = activeExecPolicy.check(ns, command)
+		}
+		if err := denyExec(ctx, c, probe, reason); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if _, err := c.Kubernetes.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get node %s: %v", nodeName, err)), nil
+		}
+
+		pod := nodeDebugPod(nodeName, utils.GetStringArg(args, "image", debugImage()), keepAlive)
+		created, err := c.Kubernetes.CoreV1().Pods(ns).Create(ctx, pod, metav1.CreateOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create debug pod for node %s in namespace %s: %v", nodeName, ns, err)), nil
+		}
+
+		// O pod só fica (keepPod) se o comando rodou; em qualquer erro ele é removido
+		kept := false
+		defer func() {
+			if kept {
+				return
+			}
+			// o contexto da requisição pode já ter expirado
+			delCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			grace := int64(0)
+			_ = c.Kubernetes.CoreV1().Pods(ns).Delete(delCtx, created.Name, metav1.DeleteOptions{GracePeriodSeconds: &grace})
+		}()
+
+		if err := waitForContainerRunning(ctx, c, ns, created.Name, "debugger", opts.wait); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Debug pod %s/%s did not start (deleted): %v", ns, created.Name, err)), nil
+		}
+
+		output, err := runDebugCommand(ctx, c, "debug_node", ns, created.Name, "debugger", command, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Debug pod %s/%s on node %s (deleted).\n%v", ns, created.Name, nodeName, err)), nil
+		}
+
+		note := fmt.Sprintf("Debug pod %s/%s on node %s (deleted after the command).", ns, created.Name, nodeName)
+		if keepPod {
+			kept = true
+			note = fmt.Sprintf("Debug pod %s/%s kept for up to %ds; delete it with delete_pod when done.", ns, created.Name, keepAlive)
+		}
+		return mcp.NewToolResultText(note + "\n\n" + output), nil
+	}
+}
+
+// nodeDebugPod monta um pod privilegiado nos namespaces do host, como o "oc debug node".
+func nodeDebugPod(nodeName, image string, keepAlive int) *corev1.Pod {
+	privileged := true
+	deadline := int64(keepAlive)
+	hostPathType := corev1.HostPathDirectory
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: strings.ReplaceAll(nodeName, ".", "-") + "-debug-",
+			Labels:       map[string]string{"app.kubernetes.io/managed-by": kube.DefaultFieldManager},
+			Annotations:  map[string]string{"debug.openshift.io/source-resource": "/v1, Resource=nodes/" + nodeName},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      nodeName,
+			HostPID:       true,
+			HostNetwork:   true,
+			HostIPC:       true,
+			RestartPolicy: corev1.RestartPolicyNever,
+			// o kubelet encerra o pod no prazo mesmo que ninguém o apague
+			ActiveDeadlineSeconds: &deadline,
+			Tolerations:           []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:    "debugger",
+				Image:   image,
+				Command: []string{"sleep", fmt.Sprint(keepAlive)},
+				SecurityContext: &corev1.SecurityContext{
+					Privileged: &privileged,
+					RunAsUser:  new(int64),
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "host", MountPath: "/host"}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "host",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/", Type: &hostPathType},
+				},
+			}},
+		},
+	}
+}
+
+// debugKeepAliveFromArgs lê keepAliveSeconds, recusando valores acima de maxDebugKeepAlive.
+func debugKeepAliveFromArgs(args map[string]any) (int, error) {
+	keepAlive := utils.GetIntArg(args, "keepAliveSeconds", defaultDebugKeepAlive)
+	if keepAlive < 1 || keepAlive > maxDebugKeepAlive {
+		return 0, fmt.Errorf("keepAliveSeconds must be between 1 and %d", maxDebugKeepAlive)
+	}
+	return keepAlive, nil
+}
+
+type debugExecOptions struct {
+	wait      time.Duration
+	timeout   time.Duration
+	maxOutput int
+}
+
+func debugExecOptionsFromArgs(args map[string]any) (debugExecOptions, error) {
+	wait := utils.GetIntArg(args, "waitSeconds", defaultDebugWaitSeconds)
+	if wait < 1 || wait > maxDebugWaitSeconds {
+		return debugExecOptions{}, fmt.Errorf("waitSeconds must be between 1 and %d", maxDebugWaitSeconds)
+	}
+	timeout := utils.GetIntArg(args, "timeoutSeconds", defaultExecTimeoutSeconds)
+	if timeout < 1 || timeout > maxExecTimeoutSeconds {
+		return debugExecOptions{}, fmt.Errorf("timeoutSeconds must be between 1 and %d", maxExecTimeoutSeconds)
+	}
+	maxOutput := utils.GetIntArg(args, "maxOutputBytes", defaultExecOutputBytes)
+	if maxOutput < 1 {
+		maxOutput = defaultExecOutputBytes
+	}
+	return debugExecOptions{
+		wait:      time.Duration(wait) * time.Second,
+		timeout:   time.Duration(timeout) * time.Second,
+		maxOutput: activeExecPolicy.outputLimit(maxOutput),
+	}, nil
+}
+
+// runDebugCommand executa o comando no container de debug e formata a saída como o exec_pod.
+func runDebugCommand(ctx context.Context, c *clients.Clients, tool, ns, pod, container string, command []string, opts debugExecOptions) (string, error) {
+	execCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	stdout := &cappedBuffer{max: opts.maxOutput}
+	stderr := &cappedBuffer{max: opts.maxOutput}
+	exitCode, err := execInPod(execCtx, c, execRequest{
+		Tool:      tool,
+		Namespace: ns,
+		Pod:       pod,
+		Container: container,
+		Command:   command,
+		Stdout:    stdout,
+		Stderr:    stderr,
+	})
+	if errors.Is(err, errExecDenied) {
+		return "", err
+	}
+	if err != nil {
+		if execCtx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %s", opts.timeout)
+		}
+		return "", fmt.Errorf("exec failed: %v\nStdout:\n%s\n\nStderr:\n%s", err, stdout.String(), stderr.String())
+	}
+	return fmt.Sprintf("Exit Code: %d\n\nStdout:\n%s\n\nStderr:\n%s", exitCode, stdout.String(), stderr.String()), nil
+}
+
+// waitForContainerRunning espera o container (regular ou ephemeral) ficar Running,
+// falhando cedo em erros de pull/criação ou se ele terminar.
+func waitForContainerRunning(ctx context.Context, c *clients.Clients, ns, pod, container string, timeout time.Duration) error {
+	var lastState string
+	err := wait.PollUntilContextTimeout(ctx, debugPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		p, err := c.Kubernetes.CoreV1().Pods(ns).Get(ctx, pod, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if p.Status.Phase == corev1.PodFailed || p.Status.Phase == corev1.PodSucceeded {
+			return false, fmt.Errorf("pod is %s", p.Status.Phase)
+		}
+
+		statuses := append(append([]corev1.ContainerStatus{}, p.Status.ContainerStatuses...), p.Status.EphemeralContainerStatuses...)
+		for _, st := range statuses {
+			if st.Name != container {
+				continue
+			}
+			switch {
+			case st.State.Running != nil:
+				return true, nil
+			case st.State.Terminated != nil:
+				t := st.State.Terminated
+				return false, fmt.Errorf("container terminated: %s (exit code %d) %s", t.Reason, t.ExitCode, t.Message)
+			case st.State.Waiting != nil:
+				w := st.State.Waiting
+				lastState = strings.TrimSpace(w.Reason + " " + w.Message)
+				switch w.Reason {
+				case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerError", "CreateContainerConfigError":
+					return false, fmt.Errorf("%s", lastState)
+				}
+			}
+		}
+		return false, nil
+	})
+	if wait.Interrupted(err) {
+		if lastState != "" {
+			return fmt.Errorf("timed out after %s (last state: %s)", timeout, lastState)
+		}
+		return fmt.Errorf("timed out after %s", timeout)
+	}
+	return err
+}
+
+func podHasContainer(pod *corev1.Pod, name string) bool {
+	for _, ctr := range pod.Spec.Containers {
+		if ctr.Name == name {
+			return true
+		}
+	}
+	for _, ctr := range pod.Spec.InitContainers {
+		if ctr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEphemeralContainer(pod *corev1.Pod, name string) bool {
+	for _, ctr := range pod.Spec.EphemeralContainers {
+		if ctr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func ephemeralContainerRunning(pod *corev1.Pod, name string) bool {
+	for _, st := range pod.Status.EphemeralContainerStatuses {
+		if st.Name == name && st.State.Running != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,477 @@
+// internal/handlers/diagnose.go
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// DIAGNOSE (classificação de falhas de pods)
+///////////////////////////////////////////////////////////////////////////////
+
+const (
+	// diagnoseLogLines é quantas linhas do log anterior acompanham um CrashLoopBackOff.
+	diagnoseLogLines = 20
+	// diagnoseRestartWarning é a partir de quantos restarts um container é sinalizado.
+	diagnoseRestartWarning = 5
+	maxDiagnosePods        = 50
+)
+
+// podFinding é um problema encontrado em um pod.
+type podFinding struct {
+	Pod       string   `json:"pod"`
+	Container string   `json:"container,omitempty"`
+	Severity  string   `json:"severity"` // critical | warning
+	Category  string   `json:"category"`
+	Message   string   `json:"message"`
+	Details   []string `json:"details,omitempty"`
+}
+
+// podDiagnosis agrupa os achados de um pod.
+type podDiagnosis struct {
+	Namespace string       `json:"namespace"`
+	Pod       string       `json:"pod"`
+	Phase     string       `json:"phase"`
+	Findings  []podFinding `json:"findings"`
+}
+
+func registerDiagnoseTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	diagnoseTool := mcp.NewTool(
+		"diagnose_pod",
+		mcp.WithDescription("Diagnose why a pod is unhealthy, combining status, events, logs and referenced objects. Classifies CrashLoopBackOff (with the last log lines), ImagePullBackOff/ErrImagePull, OOMKilled, Pending/unschedulable (with the scheduler message), failing probes, mount failures, eviction and missing ConfigMaps/Secrets/PVCs. Returns structured findings. Args: namespace (string), name (string, optional; without it every unhealthy pod in the namespace is diagnosed, up to 50), labelSelector (string, optional, with no name)."),
+	)
+	srv.AddTool(diagnoseTool, diagnosePodHandler(c))
+}
+
+func diagnosePodHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		ns := utils.GetStringArg(args, "namespace", "")
+		if ns == "" {
+			return mcp.NewToolResultError("namespace is required"), nil
+		}
+		name := utils.GetStringArg(args, "name", "")
+
+		var pods []corev1.Pod
+		if name != "" {
+			pod, err := c.Kubernetes.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod %s/%s: %v", ns, name, err)), nil
+			}
+			pods = []corev1.Pod{*pod}
+		} else {
+			list, err := c.Kubernetes.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+				LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list pods: %v", err)), nil
+			}
+			for _, p := range list.Items {
+				if podLooksUnhealthy(&p) {
+					pods = append(pods, p)
+				}
+			}
+			sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+		}
+
+		if len(pods) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("No unhealthy pods found in namespace %s.", ns)), nil
+		}
+		var skipped int
+		if len(pods) > maxDiagnosePods {
+			skipped = len(pods) - maxDiagnosePods
+			pods = pods[:maxDiagnosePods]
+		}
+
+		// uma listagem de events para todos os pods; se falhar, o diagnóstico segue sem eles.
+		// Agrupados por UID para não misturar eventos de pods anteriores com o mesmo nome.
+		filter := eventFilter{Kind: "Pod", Name: name}
+		if name != "" {
+			filter.UID = string(pods[0].UID)
+		}
+		events := map[string][]eventGroup{}
+		if groups, err := listEventGroups(ctx, c, ns, filter); err == nil {
+			for _, g := range groups {
+				events[g.UID] = append(events[g.UID], g)
+			}
+		}
+
+		refs := &refChecker{c: c, ns: ns, cache: map[string]string{}}
+		diagnoses := make([]podDiagnosis, 0, len(pods))
+		for i := range pods {
+			diagnoses = append(diagnoses, diagnosePod(ctx, c, &pods[i], events[string(pods[i].UID)], refs))
+		}
+
+		text := formatDiagnoses(diagnoses)
+		if skipped > 0 {
+			text += fmt.Sprintf("\n(%d more unhealthy pod(s) not diagnosed; narrow with labelSelector or name)\n", skipped)
+		}
+		return mcp.NewToolResultStructured(map[string]any{"pods": diagnoses}, text), nil
+	}
+}
+
+// podLooksUnhealthy seleciona pods com problema no modo namespace inteiro.
+func podLooksUnhealthy(pod *corev1.Pod) bool {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return false
+	case corev1.PodPending, corev1.PodFailed, corev1.PodUnknown:
+		return true
+	}
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, st := range statuses {
+		if st.State.Waiting != nil || st.RestartCount >= diagnoseRestartWarning {
+			return true
+		}
+		if st.LastTerminationState.Terminated != nil && st.LastTerminationState.Terminated.Reason == "OOMKilled" {
+			return true
+		}
+	}
+	for _, st := range pod.Status.ContainerStatuses {
+		if !st.Ready {
+			return true
+		}
+	}
+	return false
+}
+
+func diagnosePod(ctx context.Context, c *clients.Clients, pod *corev1.Pod, events []eventGroup, refs *refChecker) podDiagnosis {
+	d := podDiagnosis{Namespace: pod.Namespace, Pod: pod.Name, Phase: string(pod.Status.Phase)}
+	add := func(f podFinding) {
+		f.Pod = pod.Name
+		d.Findings = append(d.Findings, f)
+	}
+
+	if pod.Status.Reason == "Evicted" {
+		add(podFinding{Severity: "warning", Category: "Evicted", Message: pod.Status.Message})
+	}
+
+	// scheduling
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse {
+			add(podFinding{
+				Severity: "critical",
+				Category: "Unschedulable",
+				Message:  strings.TrimSpace(cond.Reason + ": " + cond.Message),
+			})
+		}
+	}
+
+	// containers
+	specs := map[string]corev1.Container{}
+	for _, ctr := range append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...) {
+		specs[ctr.Name] = ctr
+	}
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, st := range statuses {
+		for _, f := range diagnoseContainer(ctx, c, pod, specs[st.Name], st, events) {
+			add(f)
+		}
+	}
+
+	// probes e montagem de volumes via events
+	for _, g := range events {
+		if g.Type != corev1.EventTypeWarning {
+			continue
+		}
+		switch g.Reason {
+		case "Unhealthy":
+			add(podFinding{
+				Severity: "warning",
+				Category: "ProbeFailure",
+				Message:  fmt.Sprintf("%s (x%d, last %s)", g.Note, g.Count, formatTimeAgo(g.LastSeen)),
+			})
+		case "FailedMount", "FailedAttachVolume":
+			add(podFinding{Severity: "critical", Category: "VolumeMount", Message: g.Note})
+		case "FailedCreatePodSandBox":
+			add(podFinding{Severity: "critical", Category: "Sandbox", Message: g.Note})
+		}
+	}
+
+	// objetos referenciados
+	for _, f := range refs.check(ctx, pod) {
+		add(f)
+	}
+
+	// Pending sem causa identificada: usa o último FailedScheduling, se houver
+	if pod.Status.Phase == corev1.PodPending && len(d.Findings) == 0 {
+		msg := "pod is Pending"
+		for _, g := range events {
+			if g.Reason == "FailedScheduling" {
+				msg = g.Note
+			}
+		}
+		add(podFinding{Severity: "warning", Category: "Pending", Message: msg})
+	}
+	return d
+}
+
+func diagnoseContainer(ctx context.Context, c *clients.Clients, pod *corev1.Pod, spec corev1.Container, st corev1.ContainerStatus, events []eventGroup) []podFinding {
+	var findings []podFinding
+	last := st.LastTerminationState.Terminated
+
+	if w := st.State.Waiting; w != nil {
+		switch w.Reason {
+		case "CrashLoopBackOff":
+			msg := fmt.Sprintf("container is crash looping (%d restarts)", st.RestartCount)
+			if last != nil {
+				msg += fmt.Sprintf("; last exit: %s, code %d, %s", last.Reason, last.ExitCode, formatTimeAgo(last.FinishedAt.Time))
+			}
+			findings = append(findings, podFinding{
+				Container: st.Name,
+				Severity:  "critical",
+				Category:  "CrashLoopBackOff",
+				Message:   msg,
+				Details:   lastContainerLogLines(ctx, c, pod, st.Name),
+			})
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+			// Em back-off o status só diz "Back-off pulling image"; o erro real está no evento Failed
+			msg := fmt.Sprintf("%s for image %s: %s", w.Reason, spec.Image, w.Message)
+			if note := imagePullError(events, spec.Image); note != "" {
+				msg = fmt.Sprintf("%s for image %s: %s", w.Reason, spec.Image, note)
+			}
+			findings = append(findings, podFinding{
+				Container: st.Name,
+				Severity:  "critical",
+				Category:  "ImagePull",
+				Message:   msg,
+			})
+		case "CreateContainerConfigError", "CreateContainerError", "RunContainerError":
+			findings = append(findings, podFinding{
+				Container: st.Name,
+				Severity:  "critical",
+				Category:  "ContainerConfig",
+				Message:   strings.TrimSpace(w.Reason + ": " + w.Message),
+			})
+		}
+	}
+
+	if oom := oomTermination(st); oom != nil {
+		limit := "none"
+		if q, ok := spec.Resources.Limits[corev1.ResourceMemory]; ok {
+			limit = q.String()
+		}
+		findings = append(findings, podFinding{
+			Container: st.Name,
+			Severity:  "critical",
+			Category:  "OOMKilled",
+			Message:   fmt.Sprintf("container was OOMKilled %s (memory limit: %s)", formatTimeAgo(oom.FinishedAt.Time), limit),
+		})
+	}
+
+	if t := st.State.Terminated; t != nil && t.ExitCode != 0 && t.Reason != "OOMKilled" &&
+		pod.Spec.RestartPolicy != corev1.RestartPolicyAlways {
+		findings = append(findings, podFinding{
+			Container: st.Name,
+			Severity:  "critical",
+			Category:  "ContainerFailed",
+			Message:   fmt.Sprintf("container exited with code %d (%s) %s", t.ExitCode, t.Reason, strings.TrimSpace(t.Message)),
+			Details:   lastContainerLogLines(ctx, c, pod, st.Name),
+		})
+	}
+
+	if len(findings) == 0 && st.RestartCount >= diagnoseRestartWarning {
+		msg := fmt.Sprintf("container restarted %d times", st.RestartCount)
+		if last != nil {
+			msg += fmt.Sprintf("; last exit: %s, code %d, %s", last.Reason, last.ExitCode, formatTimeAgo(last.FinishedAt.Time))
+		}
+		findings = append(findings, podFinding{Container: st.Name, Severity: "warning", Category: "Restarts", Message: msg})
+	}
+	return findings
+}
+
+func oomTermination(st corev1.ContainerStatus) *corev1.ContainerStateTerminated {
+	if t := st.State.Terminated; t != nil && t.Reason == "OOMKilled" {
+		return t
+	}
+	if t := st.LastTerminationState.Terminated; t != nil && t.Reason == "OOMKilled" {
+		return t
+	}
+	return nil
+}
+
+// lastContainerLogLines devolve as últimas linhas da execução anterior (ou da atual, se não houver).
+func lastContainerLogLines(ctx context.Context, c *clients.Clients, pod *corev1.Pod, container string) []string {
+	tail := int64(diagnoseLogLines)
+	for _, previous := range []bool{true, false} {
+		opts := &corev1.PodLogOptions{Container: container, Previous: previous, TailLines: &tail}
+		buf, err := readPodLogs(ctx, c, pod.Namespace, pod.Name, opts, nil, maxLogLineBytes*diagnoseLogLines, nil)
+		if err == nil && len(buf.lines) > 0 {
+			return buf.lines
+		}
+	}
+	return nil
+}
+
+// refChecker verifica ConfigMaps, Secrets e PVCs referenciados, com cache por namespace.
+type refChecker struct {
+	c     *clients.Clients
+	ns    string
+	cache map[string]string // "kind/name" -> problema ("" se ok)
+}
+
+func (r *refChecker) check(ctx context.Context, pod *corev1.Pod) []podFinding {
+	type ref struct {
+		kind, name, usedBy string
+	}
+	var refs []ref
+	for _, v := range pod.Spec.Volumes {
+		switch {
+		case v.ConfigMap != nil && !isOptional(v.ConfigMap.Optional):
+			refs = append(refs, ref{"ConfigMap", v.ConfigMap.Name, "volume " + v.Name})
+		case v.Secret != nil && !isOptional(v.Secret.Optional):
+			refs = append(refs, ref{"Secret", v.Secret.SecretName, "volume " + v.Name})
+		case v.PersistentVolumeClaim != nil:
+			refs = append(refs, ref{"PersistentVolumeClaim", v.PersistentVolumeClaim.ClaimName, "volume " + v.Name})
+		case v.Projected != nil:
+			for _, src := range v.Projected.Sources {
+				if src.ConfigMap != nil && !isOptional(src.ConfigMap.Optional) {
+					refs = append(refs, ref{"ConfigMap", src.ConfigMap.Name, "volume " + v.Name})
+				}
+				if src.Secret != nil && !isOptional(src.Secret.Optional) {
+					refs = append(refs, ref{"Secret", src.Secret.Name, "volume " + v.Name})
+				}
+			}
+		}
+	}
+	for _, ctr := range append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...) {
+		for _, src := range ctr.EnvFrom {
+			if src.ConfigMapRef != nil && !isOptional(src.ConfigMapRef.Optional) {
+				refs = append(refs, ref{"ConfigMap", src.ConfigMapRef.Name, "envFrom of " + ctr.Name})
+			}
+			if src.SecretRef != nil && !isOptional(src.SecretRef.Optional) {
+				refs = append(refs, ref{"Secret", src.SecretRef.Name, "envFrom of " + ctr.Name})
+			}
+		}
+		for _, env := range ctr.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if k := env.ValueFrom.ConfigMapKeyRef; k != nil && !isOptional(k.Optional) {
+				refs = append(refs, ref{"ConfigMap", k.Name, fmt.Sprintf("env %s of %s", env.Name, ctr.Name)})
+			}
+			if k := env.ValueFrom.SecretKeyRef; k != nil && !isOptional(k.Optional) {
+				refs = append(refs, ref{"Secret", k.Name, fmt.Sprintf("env %s of %s", env.Name, ctr.Name)})
+			}
+		}
+	}
+
+	var findings []podFinding
+	seen := map[string]bool{}
+	for _, rf := range refs {
+		key := rf.kind + "/" + rf.name
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if problem := r.lookup(ctx, rf.kind, rf.name); problem != "" {
+			severity, category := "critical", "MissingReference"
+			if problem != "does not exist" {
+				severity, category = "warning", "ReferenceProblem"
+			}
+			findings = append(findings, podFinding{
+				Severity: severity,
+				Category: category,
+				Message:  fmt.Sprintf("%s %s (used by %s) %s", rf.kind, rf.name, rf.usedBy, problem),
+			})
+		}
+	}
+	return findings
+}
+
+func (r *refChecker) lookup(ctx context.Context, kind, name string) string {
+	key := kind + "/" + name
+	if problem, ok := r.cache[key]; ok {
+		return problem
+	}
+
+	var err error
+	problem := ""
+	switch kind {
+	case "ConfigMap":
+		_, err = r.c.Kubernetes.CoreV1().ConfigMaps(r.ns).Get(ctx, name, metav1.GetOptions{})
+	case "Secret":
+		_, err = r.c.Kubernetes.CoreV1().Secrets(r.ns).Get(ctx, name, metav1.GetOptions{})
+	case "PersistentVolumeClaim":
+		var pvc *corev1.PersistentVolumeClaim
+		pvc, err = r.c.Kubernetes.CoreV1().PersistentVolumeClaims(r.ns).Get(ctx, name, metav1.GetOptions{})
+		if err == nil && pvc.Status.Phase != corev1.ClaimBound {
+			problem = fmt.Sprintf("is %s", pvc.Status.Phase)
+		}
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		problem = "does not exist"
+	case apierrors.IsForbidden(err):
+		// sem permissão de leitura não dá para afirmar nada
+		problem = ""
+	case err != nil:
+		problem = fmt.Sprintf("could not be checked: %v", err)
+	}
+	r.cache[key] = problem
+	return problem
+}
+
+func isOptional(b *bool) bool {
+	return b != nil && *b
+}
+
+func formatDiagnoses(diagnoses []podDiagnosis) string {
+	var b strings.Builder
+	for i, d := range diagnoses {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "Pod %s/%s (%s)\n", d.Namespace, d.Pod, d.Phase)
+		if len(d.Findings) == 0 {
+			b.WriteString("  No problems found.\n")
+			continue
+		}
+		for _, f := range d.Findings {
+			where := ""
+			if f.Container != "" {
+				where = " [" + f.Container + "]"
+			}
+			fmt.Fprintf(&b, "  - %s %s%s: %s\n", strings.ToUpper(f.Severity), f.Category, where, f.Message)
+			if len(f.Details) > 0 {
+				b.WriteString("    Last log lines:\n")
+				for _, line := range f.Details {
+					fmt.Fprintf(&b, "      %s\n", line)
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+// imagePullError devolve a nota do evento de pull mais recente da imagem (not found,
+// unauthorized, manifest unknown...). Os grupos vêm do mais antigo para o mais recente.
+func imagePullError(events []eventGroup, image string) string {
+	for i := len(events) - 1; i >= 0; i-- {
+		g := events[i]
+		if g.Type != corev1.EventTypeWarning || (g.Reason != "Failed" && g.Reason != "ErrImagePull") {
+			continue
+		}
+		if strings.Contains(g.Note, "pull") && strings.Contains(g.Note, image) {
+			return strings.TrimSpace(g.Note)
+		}
+	}
+	return ""
+}
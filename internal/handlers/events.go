@@ -0,0 +1,235 @@
+// internal/handlers/events.go
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// EVENTS (events.k8s.io/v1)
+///////////////////////////////////////////////////////////////////////////////
+
+func registerEventTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	eventsTool := mcp.NewTool(
+		"get_events",
+		mcp.WithDescription("List Kubernetes Events as a chronological timeline, deduplicated by object and reason with counts. Args: namespace (string, optional), kind (string, optional, involved object kind), name (string, optional, involved object name), type (string, optional: Warning|Normal), since (string, optional, duration such as 30m or 2h), limit (int, optional, default 50)."),
+	)
+	srv.AddTool(eventsTool, getEventsHandler(c))
+}
+
+// eventFilter seleciona eventos pelo objeto envolvido, tipo e janela de tempo.
+// Kind, Name, UID e Type viram field selectors; Since é aplicado localmente.
+type eventFilter struct {
+	Kind  string
+	Name  string
+	UID   string // restringe a uma instância do objeto (ex.: não mistura pods recriados com o mesmo nome)
+	Type  string
+	Since time.Duration
+}
+
+// eventGroup agrega eventos repetidos do mesmo objeto com o mesmo reason.
+type eventGroup struct {
+	Type      string
+	Reason    string
+	Object    string
+	UID       string
+	Note      string
+	Count     int32
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+func getEventsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		ns := utils.GetStringArg(args, "namespace", "")
+		limit := utils.GetIntArg(args, "limit", 50)
+		filter := eventFilter{
+			Kind: utils.GetStringArg(args, "kind", ""),
+			Name: utils.GetStringArg(args, "name", ""),
+			Type: utils.GetStringArg(args, "type", ""),
+		}
+		if s := utils.GetStringArg(args, "since", ""); s != "" {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid since %q: %v", s, err)), nil
+			}
+			filter.Since = d
+		}
+
+		filter.Kind = eventKind(c, filter.Kind)
+		groups, err := listEventGroups(ctx, c, ns, filter)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list events: %v", err)), nil
+		}
+
+		var buf bytes.Buffer
+		shown := groups
+		if limit > 0 && len(shown) > limit {
+			// mantém os mais recentes
+			shown = shown[len(shown)-limit:]
+		}
+		fmt.Fprintf(&buf, "Events: %d distinct (showing %d)\n\n", len(groups), len(shown))
+		buf.WriteString(formatEventTimeline(shown))
+
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+}
+
+// listEventGroups lista os eventos do namespace (ou de todos) filtrando no servidor e
+// devolve os grupos ordenados do mais antigo para o mais recente.
+func listEventGroups(ctx context.Context, c *clients.Clients, ns string, filter eventFilter) ([]eventGroup, error) {
+	selector := fields.Set{}
+	if filter.Kind != "" {
+		selector["regarding.kind"] = filter.Kind
+	}
+	if filter.Name != "" {
+		selector["regarding.name"] = filter.Name
+	}
+	if filter.UID != "" {
+		selector["regarding.uid"] = filter.UID
+	}
+	if filter.Type != "" {
+		// field selectors diferenciam maiúsculas: "warning" -> "Warning"
+		selector["type"] = strings.ToUpper(filter.Type[:1]) + strings.ToLower(filter.Type[1:])
+	}
+
+	list, err := c.Kubernetes.EventsV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: selector.AsSelector().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var cutoff time.Time
+	if filter.Since > 0 {
+		cutoff = time.Now().Add(-filter.Since)
+	}
+
+	byKey := map[string]*eventGroup{}
+	for i := range list.Items {
+		ev := &list.Items[i]
+		first, last := eventTimes(ev)
+		if !cutoff.IsZero() && last.Before(cutoff) {
+			continue
+		}
+
+		object := ev.Regarding.Kind + "/" + ev.Regarding.Name
+		if ns == "" && ev.Regarding.Namespace != "" {
+			object = ev.Regarding.Namespace + "/" + object
+		}
+		key := object + "|" + string(ev.Regarding.UID) + "|" + ev.Type + "|" + ev.Reason
+
+		g, ok := byKey[key]
+		if !ok {
+			g = &eventGroup{
+				Type:      ev.Type,
+				Reason:    ev.Reason,
+				Object:    object,
+				UID:       string(ev.Regarding.UID),
+				FirstSeen: first,
+			}
+			byKey[key] = g
+		}
+		g.Count += eventCount(ev)
+		if first.Before(g.FirstSeen) {
+			g.FirstSeen = first
+		}
+		if !last.Before(g.LastSeen) {
+			g.LastSeen = last
+			g.Note = ev.Note
+		}
+	}
+
+	groups := make([]eventGroup, 0, len(byKey))
+	for _, g := range byKey {
+		groups = append(groups, *g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].LastSeen.Before(groups[j].LastSeen)
+	})
+	return groups, nil
+}
+
+// eventKind converte o kind informado (pod, deploy, statefulset) no Kind exato usado
+// pelo field selector; sem resolução pelo RESTMapper, usa o valor como veio.
+func eventKind(c *clients.Clients, kind string) string {
+	if kind == "" {
+		return ""
+	}
+	gvk, err := c.Mapper.KindFor(schema.GroupVersionResource{Resource: strings.ToLower(kind)})
+	if err != nil {
+		return kind
+	}
+	return gvk.Kind
+}
+
+// eventTimes devolve (primeira, última) ocorrência, cobrindo eventos novos e os convertidos de core/v1.
+func eventTimes(ev *eventsv1.Event) (time.Time, time.Time) {
+	first := ev.EventTime.Time
+	if first.IsZero() {
+		first = ev.DeprecatedFirstTimestamp.Time
+	}
+	if first.IsZero() {
+		first = ev.CreationTimestamp.Time
+	}
+
+	last := first
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		last = ev.Series.LastObservedTime.Time
+	case !ev.DeprecatedLastTimestamp.IsZero():
+		last = ev.DeprecatedLastTimestamp.Time
+	}
+	return first, last
+}
+
+func eventCount(ev *eventsv1.Event) int32 {
+	switch {
+	case ev.Series != nil && ev.Series.Count > 0:
+		return ev.Series.Count
+	case ev.DeprecatedCount > 0:
+		return ev.DeprecatedCount
+	default:
+		return 1
+	}
+}
+
+func formatEventTimeline(groups []eventGroup) string {
+	if len(groups) == 0 {
+		return "No events found.\n"
+	}
+
+	var buf bytes.Buffer
+	now := time.Now()
+	for _, g := range groups {
+		age := duration.HumanDuration(now.Sub(g.LastSeen))
+		fmt.Fprintf(&buf, "%s (%s ago)  %-7s  %s  %s", g.LastSeen.UTC().Format(time.RFC3339), age, g.Type, g.Reason, g.Object)
+		if g.Count > 1 {
+			fmt.Fprintf(&buf, "  (x%d over %s)", g.Count, duration.HumanDuration(g.LastSeen.Sub(g.FirstSeen)))
+		}
+		fmt.Fprintf(&buf, "\n    %s\n", strings.TrimSpace(g.Note))
+	}
+	return buf.String()
+}
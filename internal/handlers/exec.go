@@ -0,0 +1,194 @@
+// internal/handlers/exec.go
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// EXEC (plumbing compartilhado por exec_pod e pelos tools que usam exec)
+///////////////////////////////////////////////////////////////////////////////
+
+const (
+	defaultExecTimeoutSeconds = 60
+	maxExecTimeoutSeconds     = 3600
+	// defaultExecOutputBytes limita stdout e stderr (cada um) devolvidos ao modelo.
+	defaultExecOutputBytes = 256 * 1024
+)
+
+// errExecDenied indica que a exec policy recusou o comando.
+var errExecDenied = errors.New("exec denied by policy")
+
+// execRequest descreve um comando a executar em um container.
+type execRequest struct {
+	Tool      string // tool que originou a exec, para o audit log
+	Namespace string
+	Pod       string
+	Container string
+	Command   []string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+}
+
+// execInPod aplica a exec policy, executa o comando via SPDY e devolve o exit code remoto.
+// Um exit code diferente de zero não é tratado como erro; err indica negação, falha de transporte ou timeout.
+// Toda tentativa, permitida ou negada, vai para o audit log.
+func execInPod(ctx context.Context, c *clients.Clients, r execRequest) (int, error) {
+	if len(r.Command) == 0 {
+		return -1, fmt.Errorf("command must not be empty")
+	}
+
+	ev := auditEvent{
+		Tool:      r.Tool,
+		Namespace: r.Namespace,
+		Pod:       r.Pod,
+		Container: r.Container,
+		Command:   r.Command,
+	}
+	if reason := activeExecPolicy.check(r.Namespace, r.Command); reason != "" {
+		ev.Decision = "denied"
+		ev.Reason = reason
+		writeAudit(ctx, c, ev)
+		return -1, fmt.Errorf("%w: %s", errExecDenied, reason)
+	}
+
+	ev.Decision = "allowed"
+	exitCode, err := streamExec(ctx, c, r)
+	if err != nil {
+		ev.Error = err.Error()
+	} else {
+		ev.ExitCode = &exitCode
+	}
+	writeAudit(ctx, c, ev)
+	return exitCode, err
+}
+
+// denyExec aplica a exec policy antes de o tool alterar o cluster (ex.: criar o container
+// de debug), registrando a negação no audit log. reason vem de check ou checkDebugNode.
+func denyExec(ctx context.Context, c *clients.Clients, r execRequest, reason string) error {
+	if reason == "" {
+		return nil
+	}
+	writeAudit(ctx, c, auditEvent{
+		Tool:      r.Tool,
+		Namespace: r.Namespace,
+		Pod:       r.Pod,
+		Container: r.Container,
+		Command:   r.Command,
+		Decision:  "denied",
+		Reason:    reason,
+	})
+	return fmt.Errorf("%w: %s", errExecDenied, reason)
+}
+
+func streamExec(ctx context.Context, c *clients.Clients, r execRequest) (int, error) {
+	reqExec := c.Kubernetes.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(r.Pod).
+		Namespace(r.Namespace).
+		SubResource("exec")
+
+	execOpts := &corev1.PodExecOptions{
+		Container: r.Container,
+		Command:   r.Command,
+		Stdin:     r.Stdin != nil,
+		Stdout:    r.Stdout != nil,
+		Stderr:    r.Stderr != nil,
+	}
+
+	reqExec.VersionedParams(execOpts, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(c.RestConfig, "POST", reqExec.URL())
+	if err != nil {
+		return -1, fmt.Errorf("failed to create executor: %w", err)
+	}
+
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  r.Stdin,
+		Stdout: r.Stdout,
+		Stderr: r.Stderr,
+	})
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		return exitErr.ExitStatus(), nil
+	}
+	if err != nil {
+		return -1, err
+	}
+	return 0, nil
+}
+
+// execCommandFromArgs monta o comando a partir de command ([]string ou string com shell=true).
+func execCommandFromArgs(args map[string]any) ([]string, error) {
+	shell := utils.GetBoolArg(args, "shell", false)
+
+	switch v := args["command"].(type) {
+	case nil:
+		return nil, fmt.Errorf("command is required")
+	case string:
+		if !shell {
+			return nil, fmt.Errorf("command must be an array of strings unless shell=true")
+		}
+		if v == "" {
+			return nil, fmt.Errorf("command must not be empty")
+		}
+		return []string{"sh", "-c", v}, nil
+	case []any:
+		command := utils.InterfaceSliceToStringSlice(v)
+		if len(command) != len(v) {
+			return nil, fmt.Errorf("command must contain only strings")
+		}
+		if len(command) == 0 || command[0] == "" {
+			return nil, fmt.Errorf("command must not be empty")
+		}
+		if shell {
+			// Unir os elementos com espaço deixaria o shell re-separar e interpretar cada argumento
+			return nil, fmt.Errorf("command must be a single string when shell=true")
+		}
+		return command, nil
+	default:
+		return nil, fmt.Errorf("command must be an array of strings or a string (with shell=true)")
+	}
+}
+
+// cappedBuffer guarda até max bytes e conta o excedente descartado.
+type cappedBuffer struct {
+	buf     bytes.Buffer
+	max     int
+	dropped int64
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	room := b.max - b.buf.Len()
+	if room <= 0 {
+		b.dropped += int64(len(p))
+		return len(p), nil
+	}
+	if len(p) > room {
+		b.buf.Write(p[:room])
+		b.dropped += int64(len(p) - room)
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *cappedBuffer) String() string {
+	if b.dropped > 0 {
+		return fmt.Sprintf("%s\n[... output truncated: %d more byte(s) ...]", b.buf.String(), b.dropped)
+	}
+	return b.buf.String()
+}
@@ -0,0 +1,263 @@
+// internal/handlers/exec_policy.go
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// EXEC POLICY (allowlist de comandos por namespace, carregada de MCP_EXEC_POLICY)
+///////////////////////////////////////////////////////////////////////////////
+
+// execRule casa um argv. command é comparado com argv[0] e cada padrão com o argumento
+// da mesma posição: glob (path.Match, "/**" aceita tudo abaixo do diretório) ou regex
+// ancorada com o prefixo "re:". Caminhos são limpos (path.Clean) antes da comparação.
+//   - só command: qualquer argumento;
+//   - prefix: o argv começa com esses padrões e o resto é livre;
+//   - args: exatamente esses argumentos; com variadic, o último padrão vale para
+//     zero ou mais argumentos finais.
+type execRule struct {
+	Command  string   `json:"command"`
+	Prefix   []string `json:"prefix,omitempty"`
+	Args     []string `json:"args,omitempty"`
+	Variadic bool     `json:"variadic,omitempty"`
+}
+
+// execRules lista o que pode ser executado. deny vale antes de allow; sem allow,
+// tudo que não for negado é permitido.
+type execRules struct {
+	Allow []execRule `json:"allow,omitempty"`
+	Deny  []execRule `json:"deny,omitempty"`
+
+	regexps map[string]*regexp.Regexp // padrões "re:" compilados no validate
+}
+
+// execPolicyFile é o formato do arquivo YAML/JSON apontado por MCP_EXEC_POLICY.
+type execPolicyFile struct {
+	DeniedNamespaces []string             `json:"deniedNamespaces,omitempty"`
+	AllowDebugNode   bool                 `json:"allowDebugNode,omitempty"`
+	MaxOutputBytes   int                  `json:"maxOutputBytes,omitempty"`
+	Default          *execRules           `json:"default,omitempty"`
+	Namespaces       map[string]execRules `json:"namespaces,omitempty"`
+}
+
+type execPolicy struct {
+	file     execPolicyFile
+	loadErr  error // arquivo inválido: nega tudo (fail closed)
+	fromFile string
+}
+
+// activeExecPolicy é carregada uma vez no RegisterAllTools.
+var activeExecPolicy = &execPolicy{}
+
+func loadExecPolicy() *execPolicy {
+	p := &execPolicy{fromFile: os.Getenv("MCP_EXEC_POLICY")}
+	if p.fromFile == "" {
+		return p
+	}
+
+	data, err := os.ReadFile(p.fromFile)
+	if err == nil {
+		err = sigyaml.UnmarshalStrict(data, &p.file)
+	}
+	if err == nil && p.file.Default != nil {
+		err = p.file.Default.validate()
+	}
+	for ns, rules := range p.file.Namespaces {
+		if err != nil {
+			break
+		}
+		if err = rules.validate(); err != nil {
+			err = fmt.Errorf("namespace %s: %w", ns, err)
+		}
+		p.file.Namespaces[ns] = rules
+	}
+
+	if err != nil {
+		p.loadErr = fmt.Errorf("invalid exec policy %s: %w", p.fromFile, err)
+		log.Printf("%v; exec will be denied until it is fixed", p.loadErr)
+		return p
+	}
+	log.Printf("Loaded exec policy from %s", p.fromFile)
+	return p
+}
+
+func (r *execRules) validate() error {
+	r.regexps = map[string]*regexp.Regexp{}
+	for kind, rules := range map[string][]execRule{"allow": r.Allow, "deny": r.Deny} {
+		for i, rule := range rules {
+			if rule.Command == "" {
+				return fmt.Errorf("%s[%d]: command is required", kind, i)
+			}
+			if len(rule.Prefix) > 0 && len(rule.Args) > 0 {
+				return fmt.Errorf("%s[%d]: use either prefix or args, not both", kind, i)
+			}
+			if rule.Variadic && len(rule.Args) == 0 {
+				return fmt.Errorf("%s[%d]: variadic needs at least one args pattern", kind, i)
+			}
+			for _, pattern := range append(append([]string{rule.Command}, rule.Prefix...), rule.Args...) {
+				if err := r.compile(pattern); err != nil {
+					return fmt.Errorf("%s[%d]: invalid pattern %q: %w", kind, i, pattern, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *execRules) compile(pattern string) error {
+	if expr, ok := strings.CutPrefix(pattern, "re:"); ok {
+		re, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return err
+		}
+		r.regexps[pattern] = re
+		return nil
+	}
+	_, err := path.Match(strings.TrimSuffix(pattern, "/**"), "")
+	return err
+}
+
+func (r *execRules) allows(command []string) bool {
+	for _, rule := range r.Deny {
+		if r.matches(rule, command) {
+			return false
+		}
+	}
+	if len(r.Allow) == 0 {
+		return true
+	}
+	for _, rule := range r.Allow {
+		if r.matches(rule, command) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *execRules) matches(rule execRule, command []string) bool {
+	if len(command) == 0 || !r.matchArg(rule.Command, command[0]) {
+		return false
+	}
+	args := command[1:]
+
+	if len(rule.Args) == 0 {
+		// só command ou prefix: os argumentos depois do prefixo são livres
+		if len(args) < len(rule.Prefix) {
+			return false
+		}
+		for i, pattern := range rule.Prefix {
+			if !r.matchArg(pattern, args[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case rule.Variadic && len(args) < len(rule.Args)-1:
+		return false
+	case !rule.Variadic && len(args) != len(rule.Args):
+		return false
+	}
+	for i, arg := range args {
+		pattern := rule.Args[min(i, len(rule.Args)-1)]
+		if !r.matchArg(pattern, arg) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchArg compara um argumento com seu padrão (glob ou "re:"). Argumentos com "/" (exceto
+// URLs) são tratados como caminhos e limpos antes, para "/etc/../root" não casar com "/etc/*".
+func (r *execRules) matchArg(pattern, arg string) bool {
+	isURL := strings.Contains(arg, "://")
+	if strings.Contains(arg, "/") && !isURL {
+		arg = path.Clean(arg)
+	}
+	if re, ok := r.regexps[pattern]; ok {
+		return re.MatchString(arg)
+	}
+	if dir, ok := strings.CutSuffix(pattern, "/**"); ok {
+		if isURL {
+			return strings.HasPrefix(arg, dir+"/")
+		}
+		if dir == "" {
+			dir = "/"
+		}
+		// o próprio diretório ou qualquer caminho abaixo dele
+		for p := arg; ; p = path.Dir(p) {
+			if ok, _ := path.Match(dir, p); ok {
+				return true
+			}
+			if p == "/" || p == "." {
+				return false
+			}
+		}
+	}
+	ok, _ := path.Match(pattern, arg)
+	return ok
+}
+
+// check devolve o motivo da negação, ou "" se o comando é permitido.
+// O comando é avaliado argumento por argumento (com shell=true: "sh", "-c", <script>).
+func (p *execPolicy) check(ns string, command []string) string {
+	if p.loadErr != nil {
+		return p.loadErr.Error()
+	}
+	for _, pattern := range p.file.DeniedNamespaces {
+		if ok, _ := path.Match(pattern, ns); ok {
+			return fmt.Sprintf("exec is denied in namespace %s", ns)
+		}
+	}
+
+	rules := p.rulesFor(ns)
+	if rules == nil {
+		return ""
+	}
+	if !rules.allows(command) {
+		return fmt.Sprintf("command %q is not allowed in namespace %s by the exec policy", strings.Join(command, " "), ns)
+	}
+	return ""
+}
+
+// checkDebugNode nega o debug_node a menos que a política o libere explicitamente:
+// o pod privilegiado tem acesso total ao node, independente das regras de comando.
+func (p *execPolicy) checkDebugNode() string {
+	if p.loadErr != nil {
+		return p.loadErr.Error()
+	}
+	if !p.file.AllowDebugNode {
+		return "debug_node is disabled; set allowDebugNode: true in the exec policy (MCP_EXEC_POLICY) to enable it"
+	}
+	return ""
+}
+
+// rulesFor escolhe as regras do namespace (nome exato ou glob) e cai no default.
+func (p *execPolicy) rulesFor(ns string) *execRules {
+	if r, ok := p.file.Namespaces[ns]; ok {
+		return &r
+	}
+	for pattern, r := range p.file.Namespaces {
+		if ok, _ := path.Match(pattern, ns); ok {
+			return &r
+		}
+	}
+	return p.file.Default
+}
+
+// outputLimit aplica o teto de saída da política ao valor pedido.
+func (p *execPolicy) outputLimit(requested int) int {
+	if p.file.MaxOutputBytes > 0 && requested > p.file.MaxOutputBytes {
+		return p.file.MaxOutputBytes
+	}
+	return requested
+}
@@ -5,8 +5,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"strings"
+	"time"
 
 	"github.com/fmendonca/openshift-mcp/internal/clients"
 	"github.com/fmendonca/openshift-mcp/internal/utils"
@@ -19,9 +21,6 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-
-	"k8s.io/client-go/kubernetes/scheme"
-	"k8s.io/client-go/tools/remotecommand"
 )
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -30,10 +29,20 @@ import (
 
 // RegisterAllTools registra todos os tools do MCP em um único lugar.
 func RegisterAllTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	activeExecPolicy = loadExecPolicy()
+
 	registerPodTools(srv, c)
 	registerServiceTools(srv, c)
 	registerClusterTools(srv, c)
 	registerKubeVirtTools(srv, c)
+	registerManifestTools(srv, c)
+	registerResourceTools(srv, c)
+	registerEventTools(srv, c)
+	registerLogTools(srv, c)
+	registerCopyTools(srv, c)
+	registerPortForwardTools(srv, c)
+	registerDebugTools(srv, c)
+	registerDiagnoseTools(srv, c)
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -430,7 +439,7 @@ func listIngressesHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
 			for _, rule := range ing.Spec.Rules {
 				fmt.Fprintf(&buf, "  Host: %s\n", rule.Host)
 			}
-			fmt.Fprintln(&buf, "\n---\n")
+			fmt.Fprint(&buf, "\n---\n\n")
 		}
 
 		return mcp.NewToolResultText(buf.String()), nil
@@ -528,13 +537,13 @@ func registerPodTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
 
 	getPodTool := mcp.NewTool(
 		"get_pod",
-		mcp.WithDescription("Get pod details. Args: name (string), namespace (string)."),
+		mcp.WithDescription("Describe a pod: containers (init, regular and ephemeral) with state, last state, probes, resources and mounts, volumes, tolerations, owners, conditions and recent events. Args: name (string), namespace (string)."),
 	)
 	srv.AddTool(getPodTool, getPodHandler(c))
 
 	logsTool := mcp.NewTool(
 		"get_pod_logs",
-		mcp.WithDescription("Get pod logs. Args: name (string), namespace (string), container (string, optional), tailLines (int, optional), previous (bool, optional)."),
+		mcp.WithDescription("Get pod logs (output capped at 256KiB, keeping the newest lines). Args: name (string), namespace (string), container (string, optional), tailLines (int, optional, default 100 unless sinceSeconds/sinceTime is set), previous (bool, optional), sinceSeconds (int, optional), sinceTime (string, optional, RFC3339), timestamps (bool, optional), limitBytes (int, optional), grep (string, optional, substring filter), regex (string, optional, regular expression filter), ignoreCase (bool, optional), context (int, optional, lines around each match), follow (bool, optional, stream new lines as MCP progress notifications when the client sends a progressToken), durationSeconds (int, optional, follow duration, default 30, max 600)."),
 	)
 	srv.AddTool(logsTool, getPodLogsHandler(c))
 
@@ -544,9 +553,17 @@ func registerPodTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
 	)
 	srv.AddTool(deleteTool, deletePodHandler(c))
 
+	policy := loadDeletePolicy()
+	deletePodsTool := mcp.NewTool(
+		"delete_pods",
+		mcp.WithDescription(fmt.Sprintf("Delete or evict pods in bulk and report the outcome per pod. Protected namespaces (%s) are refused; more than %d pod(s) requires confirm equal to the number of matched pods. Args: namespace (string), labelSelector (string, optional), fieldSelector (string, optional), status (string, optional: Evicted|Completed|Failed|Pending|Running|Unknown), evict (bool, optional, use the Eviction API and respect PodDisruptionBudgets), gracePeriodSeconds (int, optional), force (bool, optional, delete immediately with grace period 0; not with evict), dryRun (string, optional: server), confirm (int, optional). At least one of labelSelector, fieldSelector or status is required.",
+			strings.Join(policy.protectedNamespaces, ", "), policy.maxObjects)),
+	)
+	srv.AddTool(deletePodsTool, deletePodsHandler(c, policy))
+
 	execTool := mcp.NewTool(
 		"exec_pod",
-		mcp.WithDescription("Exec command in pod container. Args: name (string), namespace (string), container (string, optional), command ([]string)."),
+		mcp.WithDescription("Exec command in pod container (no TTY) and return the remote exit code. Args: name (string), namespace (string), container (string, optional), command ([]string; a single string when shell=true), shell (bool, optional, run the command string through sh -c), stdin (string, optional), timeoutSeconds (int, optional, default 60, max 3600), maxOutputBytes (int, optional, per stream, default 262144)."),
 	)
 	srv.AddTool(execTool, execPodHandler(c))
 }
@@ -573,6 +590,9 @@ func listPodsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
 	}
 }
 
+// podEventsLimit limita quantos grupos de eventos o get_pod anexa.
+const podEventsLimit = 10
+
 func getPodHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		raw := req.Params.Arguments
@@ -595,7 +615,19 @@ func getPodHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod: %v", err)), nil
 		}
 
-		return mcp.NewToolResultText(formatPodDetails(pod)), nil
+		out := formatPodDetails(pod)
+
+		events, err := listEventGroups(ctx, c, ns, eventFilter{Kind: "Pod", Name: name, UID: string(pod.UID)})
+		if err != nil {
+			out += fmt.Sprintf("\nEvents: unavailable (%v)\n", err)
+		} else {
+			if len(events) > podEventsLimit {
+				events = events[len(events)-podEventsLimit:]
+			}
+			out += "\nEvents:\n" + formatEventTimeline(events)
+		}
+
+		return mcp.NewToolResultText(out), nil
 	}
 }
 
@@ -616,28 +648,54 @@ func getPodLogsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
 			return mcp.NewToolResultError("namespace is required"), nil
 		}
 
-		container := utils.GetStringArg(args, "container", "")
-		tailLines := int64(utils.GetIntArg(args, "tailLines", 100))
-		previous := utils.GetBoolArg(args, "previous", false)
+		opts, err := podLogOptionsFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		filter, err := parseLogFilter(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
-		opts := &corev1.PodLogOptions{
-			Container: container,
-			TailLines: &tailLines,
-			Previous:  previous,
+		readCtx := ctx
+		follow := utils.GetBoolArg(args, "follow", false)
+		var followFor time.Duration
+		var onLine func(string)
+		if follow {
+			secs := utils.GetIntArg(args, "durationSeconds", defaultFollowSeconds)
+			if secs < 1 || secs > maxFollowSeconds {
+				return mcp.NewToolResultError(fmt.Sprintf("durationSeconds must be between 1 and %d", maxFollowSeconds)), nil
+			}
+			followFor = time.Duration(secs) * time.Second
+			opts.Follow = true
+
+			var cancel context.CancelFunc
+			readCtx, cancel = context.WithTimeout(ctx, followFor)
+			defer cancel()
+			onLine = utils.ProgressReporter(ctx, req)
 		}
 
-		stream, err := c.Kubernetes.CoreV1().Pods(ns).GetLogs(name, opts).Stream(ctx)
+		logs, err := readPodLogs(readCtx, c, ns, name, opts, filter, maxLogOutputBytes, onLine)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod logs: %v", err)), nil
+			if logs == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod logs: %v", err)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read logs: %v\n\n%s", err, logs.String())), nil
 		}
-		defer stream.Close()
 
-		buf := new(bytes.Buffer)
-		if _, err := io.Copy(buf, stream); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to read logs: %v", err)), nil
+		if follow {
+			status := fmt.Sprintf("followed for up to %s", followFor)
+			if ctx.Err() != nil {
+				status = "follow cancelled by client"
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Logs (%s, %d line(s)):\n\n%s", status, len(logs.lines), logs.String())), nil
 		}
 
-		return mcp.NewToolResultText(buf.String()), nil
+		if filter != nil && len(logs.lines) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("No log lines matched (%s).", describeLogWindow(opts, filter))), nil
+		}
+
+		return mcp.NewToolResultText(logs.String()), nil
 	}
 }
 
@@ -684,42 +742,51 @@ func execPodHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
 		}
 
 		container := utils.GetStringArg(args, "container", "")
-		cmdRaw, ok := args["command"]
-		if !ok {
-			return mcp.NewToolResultError("command is required"), nil
+		command, err := execCommandFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-		command := utils.InterfaceSliceToStringSlice(cmdRaw)
 
-		reqExec := c.Kubernetes.CoreV1().RESTClient().Post().
-			Resource("pods").
-			Name(name).
-			Namespace(ns).
-			SubResource("exec")
+		timeout := utils.GetIntArg(args, "timeoutSeconds", defaultExecTimeoutSeconds)
+		if timeout < 1 || timeout > maxExecTimeoutSeconds {
+			return mcp.NewToolResultError(fmt.Sprintf("timeoutSeconds must be between 1 and %d", maxExecTimeoutSeconds)), nil
+		}
+		maxOutput := utils.GetIntArg(args, "maxOutputBytes", defaultExecOutputBytes)
+		if maxOutput < 1 {
+			maxOutput = defaultExecOutputBytes
+		}
+		maxOutput = activeExecPolicy.outputLimit(maxOutput)
 
-		execOpts := &corev1.PodExecOptions{
+		execCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+
+		stdout := &cappedBuffer{max: maxOutput}
+		stderr := &cappedBuffer{max: maxOutput}
+		r := execRequest{
+			Tool:      "exec_pod",
+			Namespace: ns,
+			Pod:       name,
 			Container: container,
 			Command:   command,
-			Stdout:    true,
-			Stderr:    true,
+			Stdout:    stdout,
+			Stderr:    stderr,
 		}
-
-		reqExec.VersionedParams(execOpts, scheme.ParameterCodec)
-
-		executor, err := remotecommand.NewSPDYExecutor(c.RestConfig, "POST", reqExec.URL())
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to create executor: %v", err)), nil
+		if stdin := utils.GetStringArg(args, "stdin", ""); stdin != "" {
+			r.Stdin = strings.NewReader(stdin)
 		}
 
-		var stdout, stderr bytes.Buffer
-		err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
-			Stdout: &stdout,
-			Stderr: &stderr,
-		})
+		exitCode, err := execInPod(execCtx, c, r)
+		if errors.Is(err, errExecDenied) {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Exec failed: %v\nStderr: %s", err, stderr.String())), nil
+			if execCtx.Err() == context.DeadlineExceeded {
+				err = fmt.Errorf("timed out after %ds", timeout)
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Exec failed: %v\nStdout:\n%s\n\nStderr:\n%s", err, stdout.String(), stderr.String())), nil
 		}
 
-		result := fmt.Sprintf("Stdout:\n%s\n\nStderr:\n%s", stdout.String(), stderr.String())
+		result := fmt.Sprintf("Exit Code: %d\n\nStdout:\n%s\n\nStderr:\n%s", exitCode, stdout.String(), stderr.String())
 		return mcp.NewToolResultText(result), nil
 	}
 }
@@ -734,13 +801,6 @@ func formatPodsList(pods *corev1.PodList) string {
 	return buf.String()
 }
 
-func formatPodDetails(pod *corev1.Pod) string {
-	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "Pod: %s\nNamespace: %s\nStatus: %s\nNode: %s\nPod IP: %s\nHost IP: %s\n",
-		pod.Name, pod.Namespace, pod.Status.Phase, pod.Spec.NodeName, pod.Status.PodIP, pod.Status.HostIP)
-	return buf.String()
-}
-
 ///////////////////////////////////////////////////////////////////////////////
 // SERVICES
 ///////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,539 @@
+// internal/handlers/logs.go
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// LOGS (streaming com limites de memória e filtro grep/regex)
+///////////////////////////////////////////////////////////////////////////////
+
+const (
+	// maxLogOutputBytes limita o texto devolvido ao modelo; o excedente mais antigo é descartado.
+	maxLogOutputBytes = 256 * 1024
+	// maxLogLineBytes corta linhas gigantes (ex.: JSON em uma linha só) sem carregá-las inteiras.
+	maxLogLineBytes = 16 * 1024
+	// defaultLogWorkers é o número padrão de streams lidos em paralelo pelo get_workload_logs.
+	defaultLogWorkers = 5
+	// defaultFollowSeconds / maxFollowSeconds limitam quanto tempo o get_pod_logs fica em follow.
+	defaultFollowSeconds = 30
+	maxFollowSeconds     = 600
+)
+
+func registerLogTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	workloadLogsTool := mcp.NewTool(
+		"get_workload_logs",
+		mcp.WithDescription("Get logs from every pod and container of a workload, merged by timestamp with a [pod/container] prefix (like stern). Args: kind (string, optional: Deployment|StatefulSet|DaemonSet|VirtualMachine), name (string, optional), namespace (string), labelSelector (string, optional, used instead of kind/name), container (string, optional, default all containers), tailLines (int, optional, per container, default 100), sinceSeconds (int, optional), sinceTime (string, optional, RFC3339), previous (bool, optional), timestamps (bool, optional), grep (string, optional), regex (string, optional), ignoreCase (bool, optional), context (int, optional), maxConcurrency (int, optional, default 5)."),
+	)
+	srv.AddTool(workloadLogsTool, getWorkloadLogsHandler(c))
+}
+
+// logStream identifica um container de um pod cujo log será lido.
+type logStream struct {
+	pod       string
+	container string
+}
+
+// logLine é uma linha de log já com prefixo e o timestamp usado na ordenação.
+type logLine struct {
+	ts   time.Time
+	text string
+}
+
+func getWorkloadLogsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		ns := utils.GetStringArg(args, "namespace", "")
+		if ns == "" {
+			return mcp.NewToolResultError("namespace is required"), nil
+		}
+
+		selector, source, err := workloadSelector(ctx, c, ns, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		baseOpts, err := podLogOptionsFromArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		filterArgs, err := parseLogFilter(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		showTimestamps := baseOpts.Timestamps
+		container := baseOpts.Container
+
+		pods, err := c.Kubernetes.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list pods: %v", err)), nil
+		}
+		if len(pods.Items) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("No pods found for %s (selector: %s).", source, selector)), nil
+		}
+
+		var streams []logStream
+		for _, p := range pods.Items {
+			for _, ct := range p.Spec.Containers {
+				if container == "" || ct.Name == container {
+					streams = append(streams, logStream{pod: p.Name, container: ct.Name})
+				}
+			}
+		}
+		if len(streams) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("container %q not found in pods of %s", container, source)), nil
+		}
+
+		workers := utils.GetIntArg(args, "maxConcurrency", defaultLogWorkers)
+		if workers < 1 {
+			workers = 1
+		}
+		if workers > len(streams) {
+			workers = len(streams)
+		}
+
+		var (
+			mu    sync.Mutex
+			lines []logLine
+			errs  []string
+			wg    sync.WaitGroup
+			jobs  = make(chan logStream)
+		)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for st := range jobs {
+					opts := baseOpts.DeepCopy()
+					opts.Container = st.container
+					opts.Timestamps = true
+
+					// cada stream tem seu próprio estado de contexto do grep
+					var filter *logFilter
+					if filterArgs != nil {
+						filter = &logFilter{re: filterArgs.re, context: filterArgs.context}
+					}
+
+					buf, err := readPodLogs(ctx, c, ns, st.pod, opts, filter, maxLogOutputBytes, nil)
+					prefix := fmt.Sprintf("[%s/%s] ", st.pod, st.container)
+
+					mu.Lock()
+					if err != nil {
+						errs = append(errs, fmt.Sprintf("%s: %v", strings.TrimSpace(prefix), err))
+					}
+					if buf != nil {
+						lines = append(lines, timestampedLines(buf.lines, prefix, showTimestamps)...)
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+		for _, st := range streams {
+			jobs <- st
+		}
+		close(jobs)
+		wg.Wait()
+
+		sort.SliceStable(lines, func(i, j int) bool {
+			return lines[i].ts.Before(lines[j].ts)
+		})
+		merged := &logBuffer{maxBytes: maxLogOutputBytes}
+		for _, l := range lines {
+			merged.add(l.text)
+		}
+
+		var out bytes.Buffer
+		fmt.Fprintf(&out, "Logs for %s: %d pod(s), %d stream(s)", source, len(pods.Items), len(streams))
+		if window := describeLogWindow(baseOpts, filterArgs); window != "" {
+			fmt.Fprintf(&out, " (%s)", window)
+		}
+		out.WriteString("\n")
+		if len(errs) > 0 {
+			sort.Strings(errs)
+			out.WriteString("\nErrors:\n")
+			for _, e := range errs {
+				fmt.Fprintf(&out, "  %s\n", e)
+			}
+		}
+		out.WriteString("\n")
+		if len(merged.lines) == 0 {
+			out.WriteString("No log lines.\n")
+		} else {
+			out.WriteString(merged.String())
+		}
+
+		return mcp.NewToolResultText(out.String()), nil
+	}
+}
+
+// workloadSelector resolve o label selector dos pods a partir de kind/name ou labelSelector.
+func workloadSelector(ctx context.Context, c *clients.Clients, ns string, args map[string]any) (string, string, error) {
+	if sel := utils.GetStringArg(args, "labelSelector", ""); sel != "" {
+		return sel, "selector " + sel, nil
+	}
+
+	kind := utils.GetStringArg(args, "kind", "")
+	name := utils.GetStringArg(args, "name", "")
+	if kind == "" || name == "" {
+		return "", "", fmt.Errorf("kind and name, or labelSelector, are required")
+	}
+
+	var (
+		sel *metav1.LabelSelector
+		err error
+	)
+	switch strings.ToLower(kind) {
+	case "deployment", "deploy":
+		obj, e := c.Kubernetes.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		err = e
+		if err == nil {
+			sel = obj.Spec.Selector
+		}
+		kind = "Deployment"
+	case "statefulset", "sts":
+		obj, e := c.Kubernetes.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+		err = e
+		if err == nil {
+			sel = obj.Spec.Selector
+		}
+		kind = "StatefulSet"
+	case "daemonset", "ds":
+		obj, e := c.Kubernetes.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
+		err = e
+		if err == nil {
+			sel = obj.Spec.Selector
+		}
+		kind = "DaemonSet"
+	case "virtualmachine", "vm":
+		// pods virt-launcher carregam o nome da VM neste label
+		return labels.Set{"vm.kubevirt.io/name": name}.String(), "VirtualMachine " + name, nil
+	default:
+		return "", "", fmt.Errorf("unsupported kind %q (use Deployment, StatefulSet, DaemonSet or VirtualMachine)", kind)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get %s %s: %w", kind, name, err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid selector on %s %s: %w", kind, name, err)
+	}
+	return selector.String(), kind + " " + name, nil
+}
+
+// timestampedLines separa o timestamp RFC3339 que o kubelet prefixa em cada linha.
+// Linhas sem timestamp (ex.: separadores do grep) herdam o da linha anterior.
+func timestampedLines(raw []string, prefix string, keepTimestamps bool) []logLine {
+	out := make([]logLine, 0, len(raw))
+	var last time.Time
+	for _, l := range raw {
+		text := l
+		if ts, rest, ok := strings.Cut(l, " "); ok {
+			if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+				last = t
+				if !keepTimestamps {
+					text = rest
+				}
+			}
+		}
+		out = append(out, logLine{ts: last, text: prefix + text})
+	}
+	return out
+}
+
+// logFilter seleciona linhas por regex, com linhas de contexto antes/depois como no grep -C.
+type logFilter struct {
+	re            *regexp.Regexp
+	context       int
+	skipTimestamp bool // linhas vêm com timestamp do kubelet; o regex casa só com a mensagem
+
+	before    []string
+	afterLeft int
+	unshown   int // linhas não emitidas desde a última saída; maior que before = houve salto
+	emitted   bool
+}
+
+// feed processa uma linha e devolve as linhas a emitir (incluindo separadores "--").
+func (f *logFilter) feed(line string) []string {
+	if f == nil || f.re == nil {
+		return []string{line}
+	}
+
+	subject := line
+	if f.skipTimestamp {
+		if _, rest, ok := strings.Cut(line, " "); ok {
+			subject = rest
+		}
+	}
+
+	if f.re.MatchString(subject) {
+		var out []string
+		// "--" só quando linhas foram puladas entre o bloco anterior e o contexto deste
+		if f.emitted && f.unshown > len(f.before) && f.context > 0 {
+			out = append(out, "--")
+		}
+		out = append(out, f.before...)
+		out = append(out, line)
+		f.before = f.before[:0]
+		f.afterLeft = f.context
+		f.unshown = 0
+		f.emitted = true
+		return out
+	}
+
+	if f.afterLeft > 0 {
+		f.afterLeft--
+		return []string{line}
+	}
+
+	f.unshown++
+	if f.context > 0 {
+		f.before = append(f.before, line)
+		if len(f.before) > f.context {
+			f.before = f.before[1:]
+		}
+	}
+	return nil
+}
+
+// parseLogFilter monta o filtro a partir de grep (substring), regex, ignoreCase e context.
+func parseLogFilter(args map[string]any) (*logFilter, error) {
+	grep := utils.GetStringArg(args, "grep", "")
+	expr := utils.GetStringArg(args, "regex", "")
+	if grep != "" && expr != "" {
+		return nil, fmt.Errorf("use either grep or regex, not both")
+	}
+	if grep != "" {
+		expr = regexp.QuoteMeta(grep)
+	}
+	if expr == "" {
+		return nil, nil
+	}
+	if utils.GetBoolArg(args, "ignoreCase", false) {
+		expr = "(?i)" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex: %w", err)
+	}
+	ctxLines := utils.GetIntArg(args, "context", 0)
+	if ctxLines < 0 {
+		ctxLines = 0
+	}
+	return &logFilter{re: re, context: ctxLines}, nil
+}
+
+// logBuffer guarda as últimas linhas até maxBytes, contando o que foi descartado.
+type logBuffer struct {
+	maxBytes int
+	lines    []string
+	size     int
+	dropped  int
+}
+
+func (b *logBuffer) add(line string) {
+	b.lines = append(b.lines, line)
+	b.size += len(line) + 1
+	for b.size > b.maxBytes && len(b.lines) > 1 {
+		b.size -= len(b.lines[0]) + 1
+		b.lines = b.lines[1:]
+		b.dropped++
+	}
+}
+
+func (b *logBuffer) String() string {
+	var buf bytes.Buffer
+	if b.dropped > 0 {
+		fmt.Fprintf(&buf, "[... %d earlier line(s) omitted, output limited to %d bytes ...]\n", b.dropped, b.maxBytes)
+	}
+	for _, l := range b.lines {
+		buf.WriteString(l)
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// scanLogLines lê o stream linha a linha sem bufferizar tudo; linhas maiores que
+// maxLogLineBytes são truncadas. fn devolve false para interromper a leitura.
+func scanLogLines(r io.Reader, fn func(line string) bool) error {
+	br := bufio.NewReaderSize(r, 32*1024)
+	var line []byte
+	truncated := false
+	for {
+		chunk, isPrefix, err := br.ReadLine()
+		if len(chunk) > 0 && len(line) < maxLogLineBytes {
+			room := maxLogLineBytes - len(line)
+			if len(chunk) > room {
+				chunk = chunk[:room]
+				truncated = true
+			}
+			line = append(line, chunk...)
+		} else if len(chunk) > 0 {
+			truncated = true
+		}
+
+		if err != nil {
+			if len(line) > 0 {
+				fn(finishLogLine(line, truncated))
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if isPrefix {
+			continue
+		}
+
+		if !fn(finishLogLine(line, truncated)) {
+			return nil
+		}
+		line = line[:0]
+		truncated = false
+	}
+}
+
+func finishLogLine(line []byte, truncated bool) string {
+	if truncated {
+		return string(line) + " [line truncated]"
+	}
+	return string(line)
+}
+
+// podLogOptionsFromArgs converte os argumentos comuns de log para PodLogOptions.
+func podLogOptionsFromArgs(args map[string]any) (*corev1.PodLogOptions, error) {
+	opts := &corev1.PodLogOptions{
+		Container:  utils.GetStringArg(args, "container", ""),
+		Previous:   utils.GetBoolArg(args, "previous", false),
+		Timestamps: utils.GetBoolArg(args, "timestamps", false),
+	}
+
+	sinceSeconds := utils.GetIntArg(args, "sinceSeconds", 0)
+	sinceTime := utils.GetStringArg(args, "sinceTime", "")
+	switch {
+	case sinceSeconds > 0 && sinceTime != "":
+		return nil, fmt.Errorf("use either sinceSeconds or sinceTime, not both")
+	case sinceSeconds > 0:
+		s := int64(sinceSeconds)
+		opts.SinceSeconds = &s
+	case sinceTime != "":
+		t, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sinceTime %q (expected RFC3339): %w", sinceTime, err)
+		}
+		mt := metav1.NewTime(t)
+		opts.SinceTime = &mt
+	}
+
+	// sem janela de tempo, o padrão continua sendo as últimas 100 linhas
+	tailDefault := 100
+	if opts.SinceSeconds != nil || opts.SinceTime != nil {
+		tailDefault = 0
+	}
+	if tail := utils.GetIntArg(args, "tailLines", tailDefault); tail > 0 {
+		t := int64(tail)
+		opts.TailLines = &t
+	}
+
+	if limit := utils.GetIntArg(args, "limitBytes", 0); limit > 0 {
+		l := int64(limit)
+		opts.LimitBytes = &l
+	}
+	return opts, nil
+}
+
+// readPodLogs faz o streaming dos logs aplicando filtro e limite de saída.
+// onLine (opcional) recebe cada linha emitida, para quem precisa repassá-las ao vivo.
+func readPodLogs(
+	ctx context.Context,
+	c *clients.Clients,
+	ns, name string,
+	opts *corev1.PodLogOptions,
+	filter *logFilter,
+	maxBytes int,
+	onLine func(string),
+) (*logBuffer, error) {
+	if opts.LimitBytes != nil && int(*opts.LimitBytes) < maxBytes {
+		maxBytes = int(*opts.LimitBytes)
+	}
+
+	if filter != nil {
+		filter.skipTimestamp = opts.Timestamps
+	}
+
+	stream, err := c.Kubernetes.CoreV1().Pods(ns).GetLogs(name, opts).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	out := &logBuffer{maxBytes: maxBytes}
+	err = scanLogLines(stream, func(line string) bool {
+		for _, l := range filter.feed(line) {
+			out.add(l)
+			if onLine != nil {
+				onLine(l)
+			}
+		}
+		return ctx.Err() == nil
+	})
+	if err != nil && ctx.Err() == nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func describeLogWindow(opts *corev1.PodLogOptions, filter *logFilter) string {
+	var parts []string
+	if opts.Container != "" {
+		parts = append(parts, "container="+opts.Container)
+	}
+	if opts.Previous {
+		parts = append(parts, "previous")
+	}
+	if opts.TailLines != nil {
+		parts = append(parts, fmt.Sprintf("tail=%d", *opts.TailLines))
+	}
+	if opts.SinceSeconds != nil {
+		parts = append(parts, fmt.Sprintf("since=%ds", *opts.SinceSeconds))
+	}
+	if opts.SinceTime != nil {
+		parts = append(parts, "sinceTime="+opts.SinceTime.UTC().Format(time.RFC3339))
+	}
+	if opts.LimitBytes != nil {
+		parts = append(parts, fmt.Sprintf("limitBytes=%d", *opts.LimitBytes))
+	}
+	if filter != nil {
+		parts = append(parts, fmt.Sprintf("regex=%q", filter.re.String()))
+		if filter.context > 0 {
+			parts = append(parts, fmt.Sprintf("context=%d", filter.context))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,228 @@
+// internal/handlers/manifests.go
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/kube"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// MANIFESTS (server-side apply via dynamic client + RESTMapper)
+///////////////////////////////////////////////////////////////////////////////
+
+func registerManifestTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	applyTool := mcp.NewTool(
+		"apply_manifest",
+		mcp.WithDescription("Apply YAML/JSON manifests (multi-document) using server-side apply. Args: manifest (string), namespace (string, optional, default for namespaced objects without one), fieldManager (string, optional, default openshift-mcp), force (bool, optional, take ownership of conflicting fields), dryRun (string, optional: server)."),
+	)
+	srv.AddTool(applyTool, applyManifestHandler(c))
+
+	diffTool := mcp.NewTool(
+		"diff_manifest",
+		mcp.WithDescription("Show a unified YAML diff between the live objects and the result of applying the manifests (server-side dry-run; managedFields, resourceVersion and status are stripped). Args: manifest (string), namespace (string, optional), fieldManager (string, optional), force (bool, optional), context (int, optional, default 3)."),
+	)
+	srv.AddTool(diffTool, diffManifestHandler(c))
+}
+
+func applyManifestHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		manifest := utils.GetStringArg(args, "manifest", "")
+		if strings.TrimSpace(manifest) == "" {
+			return mcp.NewToolResultError("manifest is required"), nil
+		}
+		defaultNS := utils.GetStringArg(args, "namespace", "")
+		fieldManager := utils.GetStringArg(args, "fieldManager", kube.DefaultFieldManager)
+		force := utils.GetBoolArg(args, "force", false)
+
+		dryRun, err := kube.ParseDryRun(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		objs, err := decodeManifest(manifest)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to parse manifest: %v", err)), nil
+		}
+		if len(objs) == 0 {
+			return mcp.NewToolResultError("manifest contains no objects"), nil
+		}
+
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "Applied %d object(s) (fieldManager: %s, dryRun: %s)\n\n", len(objs), fieldManager, kube.DryRunLabel(dryRun))
+
+		failed := 0
+		for i, obj := range objs {
+			res, _, err := kube.ResourceForObject(c, obj, defaultNS)
+			if err != nil {
+				failed++
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n", i+1, kube.ObjectRef(obj), err)
+				continue
+			}
+
+			outcome, conflicts, err := kube.ApplyObject(ctx, res, obj, fieldManager, force, dryRun)
+			if err != nil {
+				failed++
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n", i+1, kube.ObjectRef(obj), err)
+				for _, cf := range conflicts {
+					fmt.Fprintf(&buf, "    Conflict: %s\n", cf)
+				}
+				continue
+			}
+			fmt.Fprintf(&buf, "[%d] %s: %s\n", i+1, kube.ObjectRef(obj), outcome)
+		}
+
+		if failed > 0 {
+			fmt.Fprintf(&buf, "\n%d of %d object(s) failed.\n", failed, len(objs))
+			if failed == len(objs) {
+				return mcp.NewToolResultError(buf.String()), nil
+			}
+		}
+
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+}
+
+func diffManifestHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		manifest := utils.GetStringArg(args, "manifest", "")
+		if strings.TrimSpace(manifest) == "" {
+			return mcp.NewToolResultError("manifest is required"), nil
+		}
+		defaultNS := utils.GetStringArg(args, "namespace", "")
+		fieldManager := utils.GetStringArg(args, "fieldManager", kube.DefaultFieldManager)
+		force := utils.GetBoolArg(args, "force", false)
+		contextLines := utils.GetIntArg(args, "context", 3)
+
+		objs, err := decodeManifest(manifest)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to parse manifest: %v", err)), nil
+		}
+		if len(objs) == 0 {
+			return mcp.NewToolResultError("manifest contains no objects"), nil
+		}
+
+		var buf bytes.Buffer
+		changed := 0
+		for i, obj := range objs {
+			res, _, err := kube.ResourceForObject(c, obj, defaultNS)
+			if err != nil {
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n\n", i+1, kube.ObjectRef(obj), err)
+				continue
+			}
+
+			live, err := res.Get(ctx, obj.GetName(), metav1.GetOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n\n", i+1, kube.ObjectRef(obj), err)
+				continue
+			}
+			exists := err == nil
+
+			proposed, err := res.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+				FieldManager: fieldManager,
+				Force:        force,
+				DryRun:       []string{metav1.DryRunAll},
+			})
+			if err != nil {
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n", i+1, kube.ObjectRef(obj), err)
+				for _, cf := range kube.ApplyConflicts(err) {
+					fmt.Fprintf(&buf, "    Conflict: %s\n", cf)
+				}
+				buf.WriteString("\n")
+				continue
+			}
+
+			var before *unstructured.Unstructured
+			if exists {
+				before = kube.StripServerFields(live)
+			}
+			diff, err := kube.UnifiedYAMLDiff(before, kube.StripServerFields(proposed), contextLines)
+			if err != nil {
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n\n", i+1, kube.ObjectRef(obj), err)
+				continue
+			}
+
+			switch {
+			case !exists:
+				changed++
+				fmt.Fprintf(&buf, "[%d] %s: new object\n```diff\n%s```\n\n", i+1, kube.ObjectRef(obj), diff)
+			case diff == "":
+				fmt.Fprintf(&buf, "[%d] %s: no changes\n\n", i+1, kube.ObjectRef(obj))
+			default:
+				changed++
+				fmt.Fprintf(&buf, "[%d] %s: changed\n```diff\n%s```\n\n", i+1, kube.ObjectRef(obj), diff)
+			}
+		}
+
+		header := fmt.Sprintf("Diff of %d object(s): %d with changes\n\n", len(objs), changed)
+		return mcp.NewToolResultText(header + buf.String()), nil
+	}
+}
+
+// decodeManifest lê um ou mais documentos YAML/JSON, expandindo objetos do tipo List.
+func decodeManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	dec := utilyaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	var objs []*unstructured.Unstructured
+	for {
+		var doc map[string]any
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: doc}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, err
+			}
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			continue
+		}
+		objs = append(objs, obj)
+	}
+
+	for i, obj := range objs {
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+			return nil, fmt.Errorf("document %d: apiVersion and kind are required", i+1)
+		}
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("document %d (%s): metadata.name is required", i+1, obj.GetKind())
+		}
+	}
+	return objs, nil
+}
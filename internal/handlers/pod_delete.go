@@ -0,0 +1,199 @@
+// internal/handlers/pod_delete.go
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/kube"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// POD DELETE EM LOTE (delete ou Eviction API)
+///////////////////////////////////////////////////////////////////////////////
+
+// podStatusFilters são os valores aceitos em delete_pods.status.
+var podStatusFilters = []string{"Evicted", "Completed", "Failed", "Pending", "Running", "Unknown"}
+
+func deletePodsHandler(c *clients.Clients, policy deletePolicy) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		ns := utils.GetStringArg(args, "namespace", "")
+		if ns == "" {
+			return mcp.NewToolResultError("namespace is required"), nil
+		}
+		if policy.isProtected(ns) {
+			return mcp.NewToolResultError(fmt.Sprintf("namespace %s is protected; refusing to delete pods there", ns)), nil
+		}
+
+		labelSelector := utils.GetStringArg(args, "labelSelector", "")
+		fieldSelector := utils.GetStringArg(args, "fieldSelector", "")
+		status := utils.GetStringArg(args, "status", "")
+		if labelSelector == "" && fieldSelector == "" && status == "" {
+			return mcp.NewToolResultError("labelSelector, fieldSelector or status is required"), nil
+		}
+		if status != "" && !containsFold(podStatusFilters, status) {
+			return mcp.NewToolResultError(fmt.Sprintf("unsupported status %q (use %s)", status, strings.Join(podStatusFilters, ", "))), nil
+		}
+
+		evict := utils.GetBoolArg(args, "evict", false)
+		force := utils.GetBoolArg(args, "force", false)
+		dryRun, err := kube.ParseDryRun(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts := metav1.DeleteOptions{DryRun: dryRun}
+		if _, ok := args["gracePeriodSeconds"]; ok {
+			grace := int64(utils.GetIntArg(args, "gracePeriodSeconds", 0))
+			if grace == 0 && !force {
+				return mcp.NewToolResultError("gracePeriodSeconds=0 requires force=true"), nil
+			}
+			opts.GracePeriodSeconds = &grace
+		}
+		if force {
+			if evict {
+				return mcp.NewToolResultError("force cannot be combined with evict; eviction always honors PodDisruptionBudgets"), nil
+			}
+			if opts.GracePeriodSeconds == nil {
+				grace := int64(0)
+				opts.GracePeriodSeconds = &grace
+			}
+		}
+
+		list, err := c.Kubernetes.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: labelSelector,
+			FieldSelector: fieldSelector,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list pods: %v", err)), nil
+		}
+		var targets []corev1.Pod
+		for _, p := range list.Items {
+			if status == "" || podMatchesStatus(&p, status) {
+				targets = append(targets, p)
+			}
+		}
+		if len(targets) == 0 {
+			return mcp.NewToolResultText("No pods matched the filters."), nil
+		}
+		sort.Slice(targets, func(i, j int) bool { return targets[i].Name < targets[j].Name })
+
+		if len(targets) > policy.maxObjects {
+			confirm := utils.GetIntArg(args, "confirm", 0)
+			if confirm != len(targets) {
+				var buf bytes.Buffer
+				fmt.Fprintf(&buf, "Refusing to delete %d pod(s) without confirmation. Re-run with confirm=%d to proceed.\n\nMatched:\n", len(targets), len(targets))
+				for i, p := range targets {
+					if i == 20 {
+						fmt.Fprintf(&buf, "  ... and %d more\n", len(targets)-i)
+						break
+					}
+					fmt.Fprintf(&buf, "  - %s (%s)\n", p.Name, podStatusLabel(&p))
+				}
+				return mcp.NewToolResultError(buf.String()), nil
+			}
+		}
+
+		action := "Deleting"
+		if evict {
+			action = "Evicting"
+		}
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "%s %d pod(s) in namespace %s (dryRun: %s)\n\n", action, len(targets), ns, kube.DryRunLabel(dryRun))
+
+		counts := map[string]int{}
+		for _, p := range targets {
+			outcome := deleteOrEvictPod(ctx, c, &p, evict, opts)
+			counts[strings.SplitN(outcome, ":", 2)[0]]++
+			fmt.Fprintf(&buf, "- %s (%s): %s\n", p.Name, podStatusLabel(&p), outcome)
+		}
+
+		keys := make([]string, 0, len(counts))
+		for k := range counts {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		summary := make([]string, 0, len(keys))
+		for _, k := range keys {
+			summary = append(summary, fmt.Sprintf("%s=%d", k, counts[k]))
+		}
+		fmt.Fprintf(&buf, "\nSummary: %s\n", strings.Join(summary, ", "))
+
+		if counts["error"] == len(targets) {
+			return mcp.NewToolResultError(buf.String()), nil
+		}
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+}
+
+// deleteOrEvictPod devolve o resultado de um pod: "deleted", "evicted", "gone",
+// "blocked: <motivo do PDB>" ou "error: <erro>".
+func deleteOrEvictPod(ctx context.Context, c *clients.Clients, pod *corev1.Pod, evict bool, opts metav1.DeleteOptions) string {
+	var err error
+	if evict {
+		err = c.Kubernetes.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+			ObjectMeta:    metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			DeleteOptions: &opts,
+		})
+	} else {
+		err = c.Kubernetes.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, opts)
+	}
+
+	switch {
+	case err == nil && evict:
+		return "evicted"
+	case err == nil:
+		return "deleted"
+	case apierrors.IsNotFound(err):
+		return "gone: pod no longer exists"
+	case evict && apierrors.IsTooManyRequests(err):
+		// 429 na Eviction API = a remoção violaria um PodDisruptionBudget
+		return "blocked: " + err.Error()
+	default:
+		return "error: " + err.Error()
+	}
+}
+
+func podMatchesStatus(pod *corev1.Pod, status string) bool {
+	switch strings.ToLower(status) {
+	case "evicted":
+		return pod.Status.Phase == corev1.PodFailed && pod.Status.Reason == "Evicted"
+	case "completed":
+		return pod.Status.Phase == corev1.PodSucceeded
+	default:
+		return strings.EqualFold(string(pod.Status.Phase), status)
+	}
+}
+
+func podStatusLabel(pod *corev1.Pod) string {
+	if pod.Status.Reason != "" {
+		return string(pod.Status.Phase) + "/" + pod.Status.Reason
+	}
+	return string(pod.Status.Phase)
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,343 @@
+// internal/handlers/pod_describe.go
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// POD DESCRIBE (visão equivalente ao kubectl describe pod)
+///////////////////////////////////////////////////////////////////////////////
+
+func formatPodDetails(pod *corev1.Pod) string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Pod: %s\nNamespace: %s\n", pod.Name, pod.Namespace)
+	if pod.Spec.PriorityClassName != "" {
+		fmt.Fprintf(&buf, "Priority Class: %s\n", pod.Spec.PriorityClassName)
+	}
+	fmt.Fprintf(&buf, "Service Account: %s\n", pod.Spec.ServiceAccountName)
+	fmt.Fprintf(&buf, "Node: %s\n", pod.Spec.NodeName)
+	if pod.Status.StartTime != nil {
+		fmt.Fprintf(&buf, "Start Time: %s\n", formatTimeAgo(pod.Status.StartTime.Time))
+	}
+
+	status := string(pod.Status.Phase)
+	if pod.DeletionTimestamp != nil {
+		status = fmt.Sprintf("Terminating (since %s)", formatTimeAgo(pod.DeletionTimestamp.Time))
+	}
+	fmt.Fprintf(&buf, "Status: %s\n", status)
+	if pod.Status.Reason != "" {
+		fmt.Fprintf(&buf, "Reason: %s\n", pod.Status.Reason)
+	}
+	if pod.Status.Message != "" {
+		fmt.Fprintf(&buf, "Message: %s\n", pod.Status.Message)
+	}
+	fmt.Fprintf(&buf, "Pod IP: %s\nHost IP: %s\nQoS Class: %s\n", pod.Status.PodIP, pod.Status.HostIP, pod.Status.QOSClass)
+
+	for _, ref := range pod.OwnerReferences {
+		controller := ""
+		if ref.Controller != nil && *ref.Controller {
+			controller = " (controller)"
+		}
+		fmt.Fprintf(&buf, "Owned By: %s/%s%s\n", ref.Kind, ref.Name, controller)
+	}
+
+	writeStringMap(&buf, "Labels", pod.Labels)
+	writeStringMap(&buf, "Annotations", pod.Annotations)
+
+	initStatuses := containerStatusesByName(pod.Status.InitContainerStatuses)
+	if len(pod.Spec.InitContainers) > 0 {
+		buf.WriteString("\nInit Containers:\n")
+		for i := range pod.Spec.InitContainers {
+			ct := &pod.Spec.InitContainers[i]
+			writeContainer(&buf, ct, initStatuses[ct.Name])
+		}
+	}
+
+	statuses := containerStatusesByName(pod.Status.ContainerStatuses)
+	buf.WriteString("\nContainers:\n")
+	for i := range pod.Spec.Containers {
+		ct := &pod.Spec.Containers[i]
+		writeContainer(&buf, ct, statuses[ct.Name])
+	}
+
+	if len(pod.Spec.EphemeralContainers) > 0 {
+		ephStatuses := containerStatusesByName(pod.Status.EphemeralContainerStatuses)
+		buf.WriteString("\nEphemeral Containers:\n")
+		for i := range pod.Spec.EphemeralContainers {
+			ec := &pod.Spec.EphemeralContainers[i]
+			ct := corev1.Container(ec.EphemeralContainerCommon)
+			writeContainer(&buf, &ct, ephStatuses[ct.Name])
+			if ec.TargetContainerName != "" {
+				fmt.Fprintf(&buf, "    Target Container: %s\n", ec.TargetContainerName)
+			}
+		}
+	}
+
+	if len(pod.Status.Conditions) > 0 {
+		buf.WriteString("\nConditions:\n")
+		for _, cond := range pod.Status.Conditions {
+			fmt.Fprintf(&buf, "  %s: %s", cond.Type, cond.Status)
+			if cond.Reason != "" {
+				fmt.Fprintf(&buf, " (Reason: %s)", cond.Reason)
+			}
+			if cond.Message != "" {
+				fmt.Fprintf(&buf, " %s", cond.Message)
+			}
+			buf.WriteString("\n")
+		}
+	}
+
+	if len(pod.Spec.Volumes) > 0 {
+		buf.WriteString("\nVolumes:\n")
+		for _, v := range pod.Spec.Volumes {
+			fmt.Fprintf(&buf, "  %s: %s\n", v.Name, describeVolumeSource(v.VolumeSource))
+		}
+	}
+
+	writeStringMap(&buf, "Node-Selectors", pod.Spec.NodeSelector)
+
+	if len(pod.Spec.Tolerations) > 0 {
+		buf.WriteString("\nTolerations:\n")
+		for _, t := range pod.Spec.Tolerations {
+			fmt.Fprintf(&buf, "  %s\n", describeToleration(t))
+		}
+	}
+
+	return buf.String()
+}
+
+func writeContainer(buf *bytes.Buffer, ct *corev1.Container, cs *corev1.ContainerStatus) {
+	fmt.Fprintf(buf, "  %s:\n", ct.Name)
+	fmt.Fprintf(buf, "    Image: %s\n", ct.Image)
+	if cs != nil && cs.ImageID != "" {
+		fmt.Fprintf(buf, "    Image ID: %s\n", cs.ImageID)
+	}
+	for _, p := range ct.Ports {
+		name := ""
+		if p.Name != "" {
+			name = " (" + p.Name + ")"
+		}
+		fmt.Fprintf(buf, "    Port: %d/%s%s\n", p.ContainerPort, p.Protocol, name)
+	}
+	if len(ct.Command) > 0 {
+		fmt.Fprintf(buf, "    Command: %s\n", strings.Join(ct.Command, " "))
+	}
+	if len(ct.Args) > 0 {
+		fmt.Fprintf(buf, "    Args: %s\n", strings.Join(ct.Args, " "))
+	}
+
+	if cs != nil {
+		fmt.Fprintf(buf, "    State: %s\n", describeContainerState(cs.State))
+		if cs.LastTerminationState != (corev1.ContainerState{}) {
+			fmt.Fprintf(buf, "    Last State: %s\n", describeContainerState(cs.LastTerminationState))
+		}
+		fmt.Fprintf(buf, "    Ready: %v\n", cs.Ready)
+		fmt.Fprintf(buf, "    Restart Count: %d\n", cs.RestartCount)
+	} else {
+		buf.WriteString("    State: unknown (no status reported)\n")
+	}
+
+	if len(ct.Resources.Limits) > 0 {
+		fmt.Fprintf(buf, "    Limits: %s\n", describeResourceList(ct.Resources.Limits))
+	}
+	if len(ct.Resources.Requests) > 0 {
+		fmt.Fprintf(buf, "    Requests: %s\n", describeResourceList(ct.Resources.Requests))
+	}
+
+	if ct.LivenessProbe != nil {
+		fmt.Fprintf(buf, "    Liveness: %s\n", describeProbe(ct.LivenessProbe))
+	}
+	if ct.ReadinessProbe != nil {
+		fmt.Fprintf(buf, "    Readiness: %s\n", describeProbe(ct.ReadinessProbe))
+	}
+	if ct.StartupProbe != nil {
+		fmt.Fprintf(buf, "    Startup: %s\n", describeProbe(ct.StartupProbe))
+	}
+
+	if len(ct.Env) > 0 || len(ct.EnvFrom) > 0 {
+		fmt.Fprintf(buf, "    Environment: %d variable(s), %d source(s)\n", len(ct.Env), len(ct.EnvFrom))
+	}
+
+	if len(ct.VolumeMounts) > 0 {
+		buf.WriteString("    Mounts:\n")
+		for _, m := range ct.VolumeMounts {
+			mode := "rw"
+			if m.ReadOnly {
+				mode = "ro"
+			}
+			sub := ""
+			if m.SubPath != "" {
+				sub = ", path=" + m.SubPath
+			}
+			fmt.Fprintf(buf, "      %s from %s (%s%s)\n", m.MountPath, m.Name, mode, sub)
+		}
+	}
+}
+
+func describeContainerState(st corev1.ContainerState) string {
+	switch {
+	case st.Running != nil:
+		return fmt.Sprintf("Running (started %s)", formatTimeAgo(st.Running.StartedAt.Time))
+	case st.Waiting != nil:
+		s := "Waiting"
+		if st.Waiting.Reason != "" {
+			s += " (" + st.Waiting.Reason + ")"
+		}
+		if st.Waiting.Message != "" {
+			s += ": " + st.Waiting.Message
+		}
+		return s
+	case st.Terminated != nil:
+		t := st.Terminated
+		s := fmt.Sprintf("Terminated (Reason: %s, Exit Code: %d", t.Reason, t.ExitCode)
+		if t.Signal != 0 {
+			s += fmt.Sprintf(", Signal: %d", t.Signal)
+		}
+		s += fmt.Sprintf(", Started: %s, Finished: %s)", formatTimeAgo(t.StartedAt.Time), formatTimeAgo(t.FinishedAt.Time))
+		if t.Message != "" {
+			s += ": " + strings.TrimSpace(t.Message)
+		}
+		return s
+	default:
+		return "Unknown"
+	}
+}
+
+func describeProbe(p *corev1.Probe) string {
+	var action string
+	switch {
+	case p.HTTPGet != nil:
+		scheme := strings.ToLower(string(p.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		action = fmt.Sprintf("http-get %s://%s:%s%s", scheme, p.HTTPGet.Host, p.HTTPGet.Port.String(), p.HTTPGet.Path)
+	case p.TCPSocket != nil:
+		action = fmt.Sprintf("tcp-socket %s:%s", p.TCPSocket.Host, p.TCPSocket.Port.String())
+	case p.GRPC != nil:
+		action = fmt.Sprintf("grpc <pod>:%d", p.GRPC.Port)
+	case p.Exec != nil:
+		action = fmt.Sprintf("exec [%s]", strings.Join(p.Exec.Command, " "))
+	default:
+		action = "unknown"
+	}
+	return fmt.Sprintf("%s delay=%ds timeout=%ds period=%ds #success=%d #failure=%d",
+		action, p.InitialDelaySeconds, p.TimeoutSeconds, p.PeriodSeconds, p.SuccessThreshold, p.FailureThreshold)
+}
+
+func describeVolumeSource(v corev1.VolumeSource) string {
+	switch {
+	case v.ConfigMap != nil:
+		return "ConfigMap " + v.ConfigMap.Name
+	case v.Secret != nil:
+		return "Secret " + v.Secret.SecretName
+	case v.PersistentVolumeClaim != nil:
+		return "PersistentVolumeClaim " + v.PersistentVolumeClaim.ClaimName
+	case v.EmptyDir != nil:
+		if v.EmptyDir.Medium != "" {
+			return fmt.Sprintf("EmptyDir (medium: %s)", v.EmptyDir.Medium)
+		}
+		return "EmptyDir"
+	case v.HostPath != nil:
+		return "HostPath " + v.HostPath.Path
+	case v.Projected != nil:
+		var parts []string
+		for _, src := range v.Projected.Sources {
+			switch {
+			case src.ConfigMap != nil:
+				parts = append(parts, "ConfigMap "+src.ConfigMap.Name)
+			case src.Secret != nil:
+				parts = append(parts, "Secret "+src.Secret.Name)
+			case src.ServiceAccountToken != nil:
+				parts = append(parts, "ServiceAccountToken")
+			case src.DownwardAPI != nil:
+				parts = append(parts, "DownwardAPI")
+			}
+		}
+		return "Projected (" + strings.Join(parts, ", ") + ")"
+	case v.DownwardAPI != nil:
+		return "DownwardAPI"
+	case v.Ephemeral != nil:
+		return "Ephemeral volume"
+	case v.CSI != nil:
+		return "CSI " + v.CSI.Driver
+	case v.NFS != nil:
+		return fmt.Sprintf("NFS %s:%s", v.NFS.Server, v.NFS.Path)
+	default:
+		return "other"
+	}
+}
+
+func describeToleration(t corev1.Toleration) string {
+	s := t.Key
+	if t.Operator == corev1.TolerationOpExists {
+		if s == "" {
+			s = "<all>"
+		}
+		s += " op=Exists"
+	} else if t.Value != "" {
+		s += "=" + t.Value
+	}
+	if t.Effect != "" {
+		s += ":" + string(t.Effect)
+	}
+	if t.TolerationSeconds != nil {
+		s += fmt.Sprintf(" for %ds", *t.TolerationSeconds)
+	}
+	return s
+}
+
+func describeResourceList(rl corev1.ResourceList) string {
+	names := make([]string, 0, len(rl))
+	for name := range rl {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		q := rl[corev1.ResourceName(name)]
+		parts = append(parts, fmt.Sprintf("%s=%s", name, q.String()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func containerStatusesByName(statuses []corev1.ContainerStatus) map[string]*corev1.ContainerStatus {
+	out := make(map[string]*corev1.ContainerStatus, len(statuses))
+	for i := range statuses {
+		out[statuses[i].Name] = &statuses[i]
+	}
+	return out
+}
+
+func writeStringMap(buf *bytes.Buffer, title string, m map[string]string) {
+	if len(m) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(buf, "\n%s:\n", title)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "  %s: %s\n", k, m[k])
+	}
+}
+
+func formatTimeAgo(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return fmt.Sprintf("%s (%s ago)", t.UTC().Format(time.RFC3339), duration.HumanDuration(time.Since(t)))
+}
@@ -0,0 +1,422 @@
+// internal/handlers/portforward.go
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// PORT-FORWARD (sessões mantidas pelo servidor, sempre em localhost)
+///////////////////////////////////////////////////////////////////////////////
+
+const (
+	defaultPortForwardIdleSeconds = 900
+	maxPortForwardIdleSeconds     = 24 * 3600
+	maxPortForwardSessions        = 20
+	portForwardReadyTimeout       = 30 * time.Second
+	portForwardReapInterval       = 30 * time.Second
+)
+
+// portForwardSession é um port-forward ativo.
+type portForwardSession struct {
+	ID        string
+	Namespace string
+	Pod       string
+	Ports     []portforward.ForwardedPort
+	Started   time.Time
+	Idle      time.Duration
+
+	lastActive atomic.Int64 // unix nano do último tráfego
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+	done       chan struct{}
+	err        error // erro de ForwardPorts, válido após done
+}
+
+func (s *portForwardSession) touch() {
+	s.lastActive.Store(time.Now().UnixNano())
+}
+
+func (s *portForwardSession) idleFor() time.Duration {
+	return time.Since(time.Unix(0, s.lastActive.Load()))
+}
+
+func (s *portForwardSession) stop() {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+}
+
+// portForwardRegistry guarda as sessões e encerra as ociosas.
+type portForwardRegistry struct {
+	mu       sync.Mutex
+	sessions map[string]*portForwardSession
+	nextID   int
+	reaper   sync.Once
+}
+
+var portForwards = &portForwardRegistry{sessions: map[string]*portForwardSession{}}
+
+// Shutdown encerra todos os port-forwards ativos. Deve ser chamado quando o servidor para.
+func Shutdown() {
+	for _, s := range portForwards.removeAll() {
+		s.stop()
+		<-s.done
+	}
+}
+
+func (r *portForwardRegistry) add(s *portForwardSession) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.sessions) >= maxPortForwardSessions {
+		return fmt.Errorf("too many port-forward sessions (max %d); stop one first", maxPortForwardSessions)
+	}
+	r.nextID++
+	s.ID = "pf-" + strconv.Itoa(r.nextID)
+	r.sessions[s.ID] = s
+	r.reaper.Do(func() { go r.reap() })
+	return nil
+}
+
+func (r *portForwardRegistry) remove(id string) *portForwardSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := r.sessions[id]
+	delete(r.sessions, id)
+	return s
+}
+
+func (r *portForwardRegistry) removeAll() []*portForwardSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]*portForwardSession, 0, len(r.sessions))
+	for id, s := range r.sessions {
+		out = append(out, s)
+		delete(r.sessions, id)
+	}
+	return out
+}
+
+func (r *portForwardRegistry) list() []*portForwardSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]*portForwardSession, 0, len(r.sessions))
+	for _, s := range r.sessions {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Started.Before(out[j].Started) })
+	return out
+}
+
+// reap roda em background removendo sessões ociosas ou cujo stream caiu.
+func (r *portForwardRegistry) reap() {
+	ticker := time.NewTicker(portForwardReapInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, s := range r.list() {
+			select {
+			case <-s.done:
+				r.remove(s.ID)
+				log.Printf("Port-forward %s to %s/%s ended: %v", s.ID, s.Namespace, s.Pod, s.err)
+				continue
+			default:
+			}
+			if s.idleFor() > s.Idle {
+				r.remove(s.ID)
+				s.stop()
+				log.Printf("Port-forward %s to %s/%s stopped after %s idle", s.ID, s.Namespace, s.Pod, s.Idle)
+			}
+		}
+	}
+}
+
+func registerPortForwardTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	startTool := mcp.NewTool(
+		"start_port_forward",
+		mcp.WithDescription("Forward local ports on the server host (127.0.0.1) to a running pod. Args: name (string), namespace (string), ports (array of \"remote\" or \"local:remote\"; local 0 or omitted picks a random port), idleTimeoutSeconds (int, optional, default 900, max 86400)."),
+	)
+	srv.AddTool(startTool, startPortForwardHandler(c))
+
+	listTool := mcp.NewTool(
+		"list_port_forwards",
+		mcp.WithDescription("List active port-forward sessions started by this server."),
+	)
+	srv.AddTool(listTool, listPortForwardsHandler())
+
+	stopTool := mcp.NewTool(
+		"stop_port_forward",
+		mcp.WithDescription("Stop a port-forward session. Args: id (string, from start_port_forward/list_port_forwards), or all (bool, optional) to stop every session."),
+	)
+	srv.AddTool(stopTool, stopPortForwardHandler())
+}
+
+func startPortForwardHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		ports, err := portForwardSpecs(args["ports"])
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		idle := utils.GetIntArg(args, "idleTimeoutSeconds", defaultPortForwardIdleSeconds)
+		if idle < 1 || idle > maxPortForwardIdleSeconds {
+			return mcp.NewToolResultError(fmt.Sprintf("idleTimeoutSeconds must be between 1 and %d", maxPortForwardIdleSeconds)), nil
+		}
+
+		pod, err := c.Kubernetes.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pod %s/%s: %v", ns, name, err)), nil
+		}
+		if pod.Status.Phase != corev1.PodRunning {
+			return mcp.NewToolResultError(fmt.Sprintf("pod %s/%s is %s; port-forward needs a running pod", ns, name, pod.Status.Phase)), nil
+		}
+
+		session := &portForwardSession{
+			Namespace: ns,
+			Pod:       name,
+			Started:   time.Now(),
+			Idle:      time.Duration(idle) * time.Second,
+			stopCh:    make(chan struct{}),
+			done:      make(chan struct{}),
+		}
+		session.touch()
+
+		if err := startPortForward(c, session, ports); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to start port-forward to %s/%s: %v", ns, name, err)), nil
+		}
+		if err := portForwards.add(session); err != nil {
+			session.stop()
+			<-session.done
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Started port-forward %s to pod %s/%s:\n%s\nIdle timeout: %s",
+			session.ID, ns, name, formatForwardedPorts(session.Ports), session.Idle)), nil
+	}
+}
+
+func listPortForwardsHandler() mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sessions := portForwards.list()
+		if len(sessions) == 0 {
+			return mcp.NewToolResultText("No active port-forward sessions."), nil
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "Port-forward sessions (%d):\n\n", len(sessions))
+		for _, s := range sessions {
+			state := "active"
+			select {
+			case <-s.done:
+				state = fmt.Sprintf("ended (%v)", s.err)
+			default:
+			}
+			fmt.Fprintf(&b, "- %s: pod %s/%s, %s\n", s.ID, s.Namespace, s.Pod, state)
+			fmt.Fprintf(&b, "  Ports: %s\n", strings.ReplaceAll(strings.TrimSpace(formatForwardedPorts(s.Ports)), "\n", ", "))
+			fmt.Fprintf(&b, "  Started: %s, idle for %s (timeout %s)\n",
+				formatTimeAgo(s.Started), s.idleFor().Truncate(time.Second), s.Idle)
+		}
+		return mcp.NewToolResultText(b.String()), nil
+	}
+}
+
+func stopPortForwardHandler() mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		if utils.GetBoolArg(args, "all", false) {
+			sessions := portForwards.removeAll()
+			for _, s := range sessions {
+				s.stop()
+				<-s.done
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Stopped %d port-forward session(s).", len(sessions))), nil
+		}
+
+		id := utils.GetStringArg(args, "id", "")
+		if id == "" {
+			return mcp.NewToolResultError("id is required (or all=true)"), nil
+		}
+		s := portForwards.remove(id)
+		if s == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("port-forward session %s not found", id)), nil
+		}
+		s.stop()
+		<-s.done
+		return mcp.NewToolResultText(fmt.Sprintf("Stopped port-forward %s to pod %s/%s.", s.ID, s.Namespace, s.Pod)), nil
+	}
+}
+
+// startPortForward abre o túnel SPDY e espera os listeners locais ficarem prontos.
+// O ciclo de vida da sessão não depende do contexto da requisição.
+func startPortForward(c *clients.Clients, s *portForwardSession, ports []string) error {
+	transport, upgrader, err := spdy.RoundTripperFor(c.RestConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create SPDY transport: %w", err)
+	}
+	url := c.Kubernetes.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(s.Namespace).
+		Name(s.Pod).
+		SubResource("portforward").
+		URL()
+	dialer := &activityDialer{
+		Dialer:  spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url),
+		session: s,
+	}
+
+	readyCh := make(chan struct{})
+	errOut := &cappedBuffer{max: 4096}
+	fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, ports, s.stopCh, readyCh, io.Discard, errOut)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		s.err = fw.ForwardPorts()
+		close(s.done)
+	}()
+
+	select {
+	case <-readyCh:
+	case <-s.done:
+		if s.err == nil {
+			return fmt.Errorf("port-forward ended before it was ready: %s", strings.TrimSpace(errOut.String()))
+		}
+		return s.err
+	case <-time.After(portForwardReadyTimeout):
+		s.stop()
+		<-s.done
+		return fmt.Errorf("timed out waiting for port-forward to become ready")
+	}
+
+	s.Ports, err = fw.GetPorts()
+	if err != nil {
+		s.stop()
+		<-s.done
+		return err
+	}
+	return nil
+}
+
+// portForwardSpecs normaliza ports (números ou strings "remote"/"local:remote") para o formato do client-go.
+func portForwardSpecs(v any) ([]string, error) {
+	items, ok := v.([]any)
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("ports is required (array of \"remote\" or \"local:remote\")")
+	}
+
+	specs := make([]string, 0, len(items))
+	for _, item := range items {
+		var spec string
+		switch p := item.(type) {
+		case float64:
+			spec = strconv.Itoa(int(p))
+		case string:
+			spec = strings.TrimSpace(p)
+		default:
+			return nil, fmt.Errorf("invalid port %v", item)
+		}
+
+		local, remote := "0", spec
+		if l, r, found := strings.Cut(spec, ":"); found {
+			local, remote = l, r
+		}
+		if local == "" {
+			local = "0"
+		}
+		if n, err := strconv.Atoi(remote); err != nil || n < 1 || n > 65535 {
+			return nil, fmt.Errorf("invalid remote port in %q", spec)
+		}
+		if n, err := strconv.Atoi(local); err != nil || n < 0 || n > 65535 {
+			return nil, fmt.Errorf("invalid local port in %q", spec)
+		}
+		specs = append(specs, local+":"+remote)
+	}
+	return specs, nil
+}
+
+func formatForwardedPorts(ports []portforward.ForwardedPort) string {
+	var b strings.Builder
+	for _, p := range ports {
+		fmt.Fprintf(&b, "  127.0.0.1:%d -> %d\n", p.Local, p.Remote)
+	}
+	return b.String()
+}
+
+// activityDialer marca a sessão como ativa a cada stream aberto e a cada leitura/escrita,
+// para que o idle timeout só conte quando não há tráfego.
+type activityDialer struct {
+	httpstream.Dialer
+	session *portForwardSession
+}
+
+func (d *activityDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, proto, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return nil, proto, err
+	}
+	return &activityConn{Connection: conn, session: d.session}, proto, nil
+}
+
+type activityConn struct {
+	httpstream.Connection
+	session *portForwardSession
+}
+
+func (c *activityConn) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	c.session.touch()
+	stream, err := c.Connection.CreateStream(headers)
+	if err != nil {
+		return nil, err
+	}
+	return &activityStream{Stream: stream, session: c.session}, nil
+}
+
+type activityStream struct {
+	httpstream.Stream
+	session *portForwardSession
+}
+
+func (s *activityStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	s.session.touch()
+	return n, err
+}
+
+func (s *activityStream) Write(p []byte) (int, error) {
+	s.session.touch()
+	return s.Stream.Write(p)
+}
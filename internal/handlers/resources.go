@@ -0,0 +1,403 @@
+// internal/handlers/resources.go
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/kube"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// GENERIC RESOURCES (qualquer kind resolvido via RESTMapper)
+///////////////////////////////////////////////////////////////////////////////
+
+func registerResourceTools(srv *mcpsrv.MCPServer, c *clients.Clients) {
+	patchTool := mcp.NewTool(
+		"patch_resource",
+		mcp.WithDescription("Patch any resource. Args: kind (string, e.g. deployment, deploy, deployments.apps, Route), apiVersion (string, optional), name (string), namespace (string, required for namespaced kinds), patch (object or JSON/YAML string), patchType (string, optional: strategic|merge|json, default strategic with merge fallback for custom resources), dryRun (string, optional: server)."),
+	)
+	srv.AddTool(patchTool, patchResourceHandler(c))
+
+	labelTool := mcp.NewTool(
+		"label_resource",
+		mcp.WithDescription("Add, update or remove labels on any resource. Args: kind (string), apiVersion (string, optional), name (string), namespace (string), labels (object, optional, key -> value), remove ([]string, optional), overwrite (bool, optional, default false), dryRun (string, optional: server)."),
+	)
+	srv.AddTool(labelTool, metadataMapHandler(c, "labels"))
+
+	annotateTool := mcp.NewTool(
+		"annotate_resource",
+		mcp.WithDescription("Add, update or remove annotations on any resource. Args: kind (string), apiVersion (string, optional), name (string), namespace (string), annotations (object, optional, key -> value), remove ([]string, optional), overwrite (bool, optional, default false), dryRun (string, optional: server)."),
+	)
+	srv.AddTool(annotateTool, metadataMapHandler(c, "annotations"))
+
+	policy := loadDeletePolicy()
+	deleteTool := mcp.NewTool(
+		"delete_resource",
+		mcp.WithDescription(fmt.Sprintf("Delete resources of any kind by name or selector. Protected namespaces (%s) are refused; deleting more than %d object(s) requires confirm equal to the number of matched objects. Args: kind (string), apiVersion (string, optional), name (string, optional), namespace (string, required for namespaced kinds), labelSelector (string, optional), fieldSelector (string, optional), propagationPolicy (string, optional: foreground|background|orphan), gracePeriodSeconds (int, optional), dryRun (string, optional: server), confirm (int, optional).",
+			strings.Join(policy.protectedNamespaces, ", "), policy.maxObjects)),
+	)
+	srv.AddTool(deleteTool, deleteResourceHandler(c, policy))
+}
+
+func patchResourceHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		if name == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		dryRun, err := kube.ParseDryRun(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		data, err := patchBytes(args["patch"])
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid patch: %v", err)), nil
+		}
+
+		patchTypeArg := utils.GetStringArg(args, "patchType", "")
+		pt, err := parsePatchType(patchTypeArg)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		mapping, res, err := kube.ResourceClientFromArgs(c, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		before, err := res.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get %s %s: %v", mapping.GroupVersionKind.Kind, name, err)), nil
+		}
+
+		after, err := res.Patch(ctx, name, pt, data, metav1.PatchOptions{FieldManager: kube.DefaultFieldManager, DryRun: dryRun})
+		if err != nil && patchTypeArg == "" && apierrors.IsUnsupportedMediaType(err) {
+			// custom resources não suportam strategic merge patch
+			pt = types.MergePatchType
+			after, err = res.Patch(ctx, name, pt, data, metav1.PatchOptions{FieldManager: kube.DefaultFieldManager, DryRun: dryRun})
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to patch %s %s: %v", mapping.GroupVersionKind.Kind, name, err)), nil
+		}
+
+		return changeSummary(before, after, fmt.Sprintf("patched (%s)", pt), dryRun)
+	}
+}
+
+// metadataMapHandler implementa label_resource e annotate_resource (field = labels|annotations).
+func metadataMapHandler(c *clients.Clients, field string) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		if name == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		dryRun, err := kube.ParseDryRun(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		overwrite := utils.GetBoolArg(args, "overwrite", false)
+
+		set := map[string]string{}
+		if m, ok := args[field].(map[string]any); ok {
+			for k, v := range m {
+				if v == nil {
+					continue
+				}
+				if s, ok := v.(string); ok {
+					set[k] = s
+				} else {
+					set[k] = fmt.Sprint(v)
+				}
+			}
+		}
+		remove := utils.InterfaceSliceToStringSlice(args["remove"])
+		if len(set) == 0 && len(remove) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("%s or remove is required", field)), nil
+		}
+
+		mapping, res, err := kube.ResourceClientFromArgs(c, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		before, err := res.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get %s %s: %v", mapping.GroupVersionKind.Kind, name, err)), nil
+		}
+
+		current, _, _ := unstructured.NestedStringMap(before.Object, "metadata", field)
+		var clashes []string
+		for k, v := range set {
+			if old, exists := current[k]; exists && old != v && !overwrite {
+				clashes = append(clashes, fmt.Sprintf("%s (current: %q)", k, old))
+			}
+		}
+		if len(clashes) > 0 {
+			sort.Strings(clashes)
+			return mcp.NewToolResultError(fmt.Sprintf(
+				"%s already set with a different value, use overwrite=true to replace: %s",
+				field, strings.Join(clashes, ", "))), nil
+		}
+
+		values := map[string]any{}
+		for k, v := range set {
+			values[k] = v
+		}
+		for _, k := range remove {
+			values[k] = nil
+		}
+		// resourceVersion garante que a checagem de overwrite vale para a versão que vamos alterar
+		patch := map[string]any{
+			"metadata": map[string]any{
+				field:             values,
+				"resourceVersion": before.GetResourceVersion(),
+			},
+		}
+		data, _ := json.Marshal(patch)
+
+		after, err := res.Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{FieldManager: kube.DefaultFieldManager, DryRun: dryRun})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update %s on %s %s: %v", field, mapping.GroupVersionKind.Kind, name, err)), nil
+		}
+
+		return changeSummary(before, after, field+" updated", dryRun)
+	}
+}
+
+// deletePolicy define as travas de segurança do delete_resource.
+type deletePolicy struct {
+	protectedNamespaces []string // padrões glob (path.Match)
+	maxObjects          int
+}
+
+// loadDeletePolicy lê MCP_PROTECTED_NAMESPACES (lista separada por vírgula)
+// e MCP_DELETE_MAX_OBJECTS do ambiente.
+func loadDeletePolicy() deletePolicy {
+	p := deletePolicy{
+		protectedNamespaces: []string{"openshift-*", "kube-*"},
+		maxObjects:          1,
+	}
+	if v, ok := os.LookupEnv("MCP_PROTECTED_NAMESPACES"); ok {
+		p.protectedNamespaces = nil
+		for _, pattern := range strings.Split(v, ",") {
+			if pattern = strings.TrimSpace(pattern); pattern != "" {
+				p.protectedNamespaces = append(p.protectedNamespaces, pattern)
+			}
+		}
+	}
+	if v := os.Getenv("MCP_DELETE_MAX_OBJECTS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			p.maxObjects = n
+		} else {
+			log.Printf("Ignoring invalid MCP_DELETE_MAX_OBJECTS=%q", v)
+		}
+	}
+	return p
+}
+
+func (p deletePolicy) isProtected(ns string) bool {
+	for _, pattern := range p.protectedNamespaces {
+		if ok, _ := path.Match(pattern, ns); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteResourceHandler(c *clients.Clients, policy deletePolicy) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		labelSelector := utils.GetStringArg(args, "labelSelector", "")
+		fieldSelector := utils.GetStringArg(args, "fieldSelector", "")
+		if name == "" && labelSelector == "" && fieldSelector == "" {
+			return mcp.NewToolResultError("name, labelSelector or fieldSelector is required"), nil
+		}
+		if name != "" && (labelSelector != "" || fieldSelector != "") {
+			return mcp.NewToolResultError("name cannot be combined with labelSelector/fieldSelector"), nil
+		}
+
+		dryRun, err := kube.ParseDryRun(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opts := metav1.DeleteOptions{DryRun: dryRun}
+		if pp := utils.GetStringArg(args, "propagationPolicy", ""); pp != "" {
+			propagation, err := parsePropagationPolicy(pp)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.PropagationPolicy = &propagation
+		}
+		if _, ok := args["gracePeriodSeconds"]; ok {
+			grace := int64(utils.GetIntArg(args, "gracePeriodSeconds", 0))
+			opts.GracePeriodSeconds = &grace
+		}
+
+		mapping, res, err := kube.ResourceClientFromArgs(c, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		kind := mapping.GroupVersionKind.Kind
+		ns := ""
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			ns = utils.GetStringArg(args, "namespace", "")
+			if policy.isProtected(ns) {
+				return mcp.NewToolResultError(fmt.Sprintf("namespace %s is protected; refusing to delete %s objects there", ns, kind)), nil
+			}
+		}
+
+		targets := []string{name}
+		if name == "" {
+			list, err := res.List(ctx, metav1.ListOptions{
+				LabelSelector: labelSelector,
+				FieldSelector: fieldSelector,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list %s: %v", kind, err)), nil
+			}
+			targets = targets[:0]
+			for _, item := range list.Items {
+				targets = append(targets, item.GetName())
+			}
+			if len(targets) == 0 {
+				return mcp.NewToolResultText(fmt.Sprintf("No %s objects matched the selector.", kind)), nil
+			}
+		}
+
+		if mapping.GroupVersionKind.GroupKind() == (schema.GroupKind{Kind: "Namespace"}) ||
+			mapping.GroupVersionKind.GroupKind() == (schema.GroupKind{Group: "project.openshift.io", Kind: "Project"}) {
+			for _, t := range targets {
+				if policy.isProtected(t) {
+					return mcp.NewToolResultError(fmt.Sprintf("namespace %s is protected; refusing to delete it", t)), nil
+				}
+			}
+		}
+
+		if len(targets) > policy.maxObjects {
+			confirm := utils.GetIntArg(args, "confirm", 0)
+			if confirm != len(targets) {
+				var buf bytes.Buffer
+				fmt.Fprintf(&buf, "Refusing to delete %d %s object(s) without confirmation. Re-run with confirm=%d to proceed.\n\nMatched:\n", len(targets), kind, len(targets))
+				for i, t := range targets {
+					if i == 20 {
+						fmt.Fprintf(&buf, "  ... and %d more\n", len(targets)-i)
+						break
+					}
+					fmt.Fprintf(&buf, "  - %s\n", t)
+				}
+				return mcp.NewToolResultError(buf.String()), nil
+			}
+		}
+
+		var buf bytes.Buffer
+		where := ""
+		if ns != "" {
+			where = " in namespace " + ns
+		}
+		fmt.Fprintf(&buf, "Deleting %d %s object(s)%s (dryRun: %s)\n\n", len(targets), kind, where, kube.DryRunLabel(dryRun))
+		failed := 0
+		for _, t := range targets {
+			if err := res.Delete(ctx, t, opts); err != nil {
+				failed++
+				fmt.Fprintf(&buf, "- %s: error: %v\n", t, err)
+				continue
+			}
+			fmt.Fprintf(&buf, "- %s: deleted\n", t)
+		}
+
+		if failed == len(targets) {
+			return mcp.NewToolResultError(buf.String()), nil
+		}
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+}
+
+func parsePropagationPolicy(s string) (metav1.DeletionPropagation, error) {
+	switch strings.ToLower(s) {
+	case "foreground":
+		return metav1.DeletePropagationForeground, nil
+	case "background":
+		return metav1.DeletePropagationBackground, nil
+	case "orphan":
+		return metav1.DeletePropagationOrphan, nil
+	default:
+		return "", fmt.Errorf("unsupported propagationPolicy %q (use foreground, background or orphan)", s)
+	}
+}
+
+// changeSummary descreve o resultado de uma mutação com o diff dos campos alterados.
+func changeSummary(before, after *unstructured.Unstructured, action string, dryRun []string) (*mcp.CallToolResult, error) {
+	text, err := kube.FormatChange(before, after, action, dryRun)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to compute diff: %v", err)), nil
+	}
+	return mcp.NewToolResultText(text), nil
+}
+
+func parsePatchType(s string) (types.PatchType, error) {
+	switch strings.ToLower(s) {
+	case "", "strategic":
+		return types.StrategicMergePatchType, nil
+	case "merge":
+		return types.MergePatchType, nil
+	case "json":
+		return types.JSONPatchType, nil
+	default:
+		return "", fmt.Errorf("unsupported patchType %q (use strategic, merge or json)", s)
+	}
+}
+
+// patchBytes aceita o patch como objeto/array JSON ou como string JSON/YAML.
+func patchBytes(v any) ([]byte, error) {
+	switch t := v.(type) {
+	case nil:
+		return nil, fmt.Errorf("patch is required")
+	case string:
+		if strings.TrimSpace(t) == "" {
+			return nil, fmt.Errorf("patch is required")
+		}
+		return sigyaml.YAMLToJSON([]byte(t))
+	default:
+		return json.Marshal(t)
+	}
+}
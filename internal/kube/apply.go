@@ -0,0 +1,107 @@
+// Package kube reúne helpers do dynamic client (RESTMapper, server-side apply, dryRun e
+// diff em YAML), compartilhados entre internal/handlers e os pacotes de internal/tools.
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+const DefaultFieldManager = "openshift-mcp"
+
+// ApplyObject faz o server-side apply de um objeto e classifica o resultado
+// como created, configured ou unchanged comparando com o objeto vivo.
+func ApplyObject(
+	ctx context.Context,
+	res dynamic.ResourceInterface,
+	obj *unstructured.Unstructured,
+	fieldManager string,
+	force bool,
+	dryRun []string,
+) (string, []string, error) {
+	live, err := res.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", nil, err
+	}
+	exists := err == nil
+
+	applied, err := res.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: fieldManager,
+		Force:        force,
+		DryRun:       dryRun,
+	})
+	if err != nil {
+		return "", ApplyConflicts(err), err
+	}
+
+	switch {
+	case !exists:
+		return "created", nil, nil
+	case reflect.DeepEqual(StripServerFields(live).Object, StripServerFields(applied).Object):
+		return "unchanged", nil, nil
+	default:
+		return "configured", nil, nil
+	}
+}
+
+// ApplyConflicts extrai os campos em conflito (e seus managers) de um erro 409 do apply.
+func ApplyConflicts(err error) []string {
+	var status apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &status) {
+		return nil
+	}
+	details := status.Status().Details
+	if details == nil {
+		return nil
+	}
+
+	var out []string
+	for _, cause := range details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+	}
+	return out
+}
+
+// ResourceForObject resolve o GVR do objeto via RESTMapper e devolve o client
+// dinâmico já posicionado no namespace correto (quando namespaced).
+func ResourceForObject(c *clients.Clients, obj *unstructured.Unstructured, defaultNS string) (dynamic.ResourceInterface, *meta.RESTMapping, error) {
+	gvk := obj.GroupVersionKind()
+
+	mapping, err := c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		// CRD pode ter sido criado depois do cache de discovery
+		meta.MaybeResetRESTMapper(c.Mapper)
+		mapping, err = c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve resource for %s: %w", gvk.String(), err)
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		obj.SetNamespace("")
+		return c.Dynamic.Resource(mapping.Resource), mapping, nil
+	}
+
+	ns := obj.GetNamespace()
+	if ns == "" {
+		ns = defaultNS
+	}
+	if ns == "" {
+		ns = metav1.NamespaceDefault
+	}
+	obj.SetNamespace(ns)
+	return c.Dynamic.Resource(mapping.Resource).Namespace(ns), mapping, nil
+}
@@ -0,0 +1,65 @@
+package kube
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+// UnifiedYAMLDiff serializa os dois objetos em YAML e gera um diff unificado.
+// before nil representa um objeto que ainda não existe no cluster.
+func UnifiedYAMLDiff(before, after *unstructured.Unstructured, contextLines int) (string, error) {
+	var a []byte
+	if before != nil {
+		var err error
+		if a, err = sigyaml.Marshal(before.Object); err != nil {
+			return "", err
+		}
+	}
+	b, err := sigyaml.Marshal(after.Object)
+	if err != nil {
+		return "", err
+	}
+	if bytes.Equal(a, b) {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(a)),
+		B:        difflib.SplitLines(string(b)),
+		FromFile: "live",
+		ToFile:   "proposed",
+		Context:  contextLines,
+	})
+}
+
+// FormatChange descreve o resultado de uma mutação com o diff dos campos alterados.
+func FormatChange(before, after *unstructured.Unstructured, action string, dryRun []string) (string, error) {
+	diff, err := UnifiedYAMLDiff(StripServerFields(before), StripServerFields(after), 3)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %s (dryRun: %s)\n\n", ObjectRef(after), action, DryRunLabel(dryRun))
+	if diff == "" {
+		buf.WriteString("No changes.\n")
+	} else {
+		fmt.Fprintf(&buf, "Changes:\n```diff\n%s```\n", diff)
+	}
+	return buf.String(), nil
+}
+
+// StripServerFields remove campos mantidos pelo servidor que só poluem comparações e diffs.
+func StripServerFields(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	out := obj.DeepCopy()
+	unstructured.RemoveNestedField(out.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(out.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(out.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(out.Object, "status")
+	return out
+}
@@ -0,0 +1,108 @@
+package kube
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// ResourceClientFromArgs resolve kind/apiVersion/namespace dos argumentos para um client dinâmico.
+func ResourceClientFromArgs(c *clients.Clients, args map[string]any) (*meta.RESTMapping, dynamic.ResourceInterface, error) {
+	kind := utils.GetStringArg(args, "kind", "")
+	if kind == "" {
+		return nil, nil, fmt.Errorf("kind is required")
+	}
+
+	mapping, err := ResolveResource(c, kind, utils.GetStringArg(args, "apiVersion", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return mapping, c.Dynamic.Resource(mapping.Resource), nil
+	}
+
+	ns := utils.GetStringArg(args, "namespace", "")
+	if ns == "" {
+		return nil, nil, fmt.Errorf("namespace is required for %s", mapping.GroupVersionKind.Kind)
+	}
+	return mapping, c.Dynamic.Resource(mapping.Resource).Namespace(ns), nil
+}
+
+// ResolveResource aceita kind, plural, short name ou forma qualificada
+// (deployment, deploy, deployments.apps, deployments.v1.apps).
+func ResolveResource(c *clients.Clients, kind, apiVersion string) (*meta.RESTMapping, error) {
+	var gvr schema.GroupVersionResource
+	if apiVersion != "" {
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid apiVersion %q: %w", apiVersion, err)
+		}
+		gvr = gv.WithResource(kind)
+	} else if fully, gr := schema.ParseResourceArg(kind); fully != nil {
+		gvr = *fully
+	} else {
+		gvr = gr.WithVersion("")
+	}
+
+	gvk, err := c.Mapper.KindFor(gvr)
+	if meta.IsNoMatchError(err) {
+		meta.MaybeResetRESTMapper(c.Mapper)
+		gvk, err = c.Mapper.KindFor(gvr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve kind %q: %w", kind, err)
+	}
+
+	mapping, err := c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve kind %q: %w", kind, err)
+	}
+	return mapping, nil
+}
+
+// ParseDryRun interpreta o argumento dryRun ("server" ou true) para as opções da API.
+func ParseDryRun(args map[string]any) ([]string, error) {
+	switch v := args["dryRun"].(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		if v {
+			return []string{metav1.DryRunAll}, nil
+		}
+		return nil, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "", "none", "false":
+			return nil, nil
+		case "server", "true", "all":
+			return []string{metav1.DryRunAll}, nil
+		default:
+			return nil, fmt.Errorf("unsupported dryRun value %q (use \"server\")", v)
+		}
+	default:
+		return nil, fmt.Errorf("invalid dryRun value (expected string \"server\" or bool)")
+	}
+}
+
+func DryRunLabel(dryRun []string) string {
+	if len(dryRun) > 0 {
+		return "server"
+	}
+	return "none"
+}
+
+func ObjectRef(obj *unstructured.Unstructured) string {
+	if ns := obj.GetNamespace(); ns != "" {
+		return fmt.Sprintf("%s %s/%s", obj.GetKind(), ns, obj.GetName())
+	}
+	return fmt.Sprintf("%s %s", obj.GetKind(), obj.GetName())
+}
@@ -6,13 +6,14 @@ import (
 	"fmt"
 
 	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/clusterstatus"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
 // newClusterInfoHandler: informações gerais do cluster Kubernetes.
 func newClusterInfoHandler(c *clients.Clients) server.ResourceHandlerFunc {
-	return func(ctx context.Context, req mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
 		sv, err := c.Kubernetes.Discovery().ServerVersion()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get server version: %w", err)
@@ -33,51 +34,45 @@ func newClusterInfoHandler(c *clients.Clients) server.ResourceHandlerFunc {
 			"apiGroupCount": len(groups.Groups),
 		}
 
-		data, err := json.MarshalIndent(info, "", "  ")
+		return jsonContents(req.Params.URI, info)
+	}
+}
+
+// newOpenShiftVersionHandler: versão, canal, histórico e updates disponíveis do ClusterVersion.
+func newOpenShiftVersionHandler(c *clients.Clients) server.ResourceHandlerFunc {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		version, err := clusterstatus.GetVersion(ctx, c)
 		if err != nil {
 			return nil, err
 		}
 
-		return &mcp.ReadResourceResult{
-			Contents: []mcp.ResourceContent{
-				mcp.TextResourceContent{
-					URI:      req.Params.URI,
-					MIMEType: "application/json",
-					Text:     string(data),
-				},
-			},
-		}, nil
+		var info any = version
+		if version == nil {
+			info = map[string]any{
+				"openshift": false,
+				"message":   "config.openshift.io ClusterVersion not found; only Kubernetes info is available.",
+			}
+		}
+
+		return jsonContents(req.Params.URI, info)
 	}
 }
 
-// newOpenShiftVersionHandler: por enquanto devolve um stub (sem usar openshift/client-go).
-func newOpenShiftVersionHandler(c *clients.Clients) server.ResourceHandlerFunc {
-	return func(ctx context.Context, req mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
-		info := map[string]any{
-			"openshift": false,
-			"message":   "OpenShift client not wired; only Kubernetes info is available.",
-		}
-
-		data, err := json.MarshalIndent(info, "", "  ")
+// newOpenShiftOperatorsHandler: ClusterVersion e condições de todos os ClusterOperators.
+func newOpenShiftOperatorsHandler(c *clients.Clients) server.ResourceHandlerFunc {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		report, err := clusterstatus.Collect(ctx, c)
 		if err != nil {
 			return nil, err
 		}
 
-		return &mcp.ReadResourceResult{
-			Contents: []mcp.ResourceContent{
-				mcp.TextResourceContent{
-					URI:      req.Params.URI,
-					MIMEType: "application/json",
-					Text:     string(data),
-				},
-			},
-		}, nil
+		return jsonContents(req.Params.URI, report)
 	}
 }
 
 // newAPIGroupsHandler: lista todos os API groups disponíveis no cluster.
 func newAPIGroupsHandler(c *clients.Clients) server.ResourceHandlerFunc {
-	return func(ctx context.Context, req mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
 		groups, err := c.Kubernetes.Discovery().ServerGroups()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get API groups: %w", err)
@@ -101,19 +96,22 @@ func newAPIGroupsHandler(c *clients.Clients) server.ResourceHandlerFunc {
 			out = append(out, gi)
 		}
 
-		data, err := json.MarshalIndent(out, "", "  ")
-		if err != nil {
-			return nil, err
-		}
+		return jsonContents(req.Params.URI, out)
+	}
+}
 
-		return &mcp.ReadResourceResult{
-			Contents: []mcp.ResourceContent{
-				mcp.TextResourceContent{
-					URI:      req.Params.URI,
-					MIMEType: "application/json",
-					Text:     string(data),
-				},
-			},
-		}, nil
+// jsonContents serializa v como o conteúdo JSON do resource.
+func jsonContents(uri string, v any) ([]mcp.ResourceContents, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
 	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      uri,
+			MIMEType: "application/json",
+			Text:     string(data),
+		},
+	}, nil
 }
@@ -21,11 +21,20 @@ func RegisterResources(srv *server.MCPServer, c *clients.Clients) {
 	verRes := mcp.NewResource(
 		"cluster://openshift/version",
 		"OpenShift Version",
-		mcp.WithResourceDescription("OpenShift cluster version and channel (if available)"),
+		mcp.WithResourceDescription("OpenShift cluster version, channel, update history and available updates"),
 		mcp.WithMIMEType("application/json"),
 	)
 	srv.AddResource(verRes, newOpenShiftVersionHandler(c))
 
+	// cluster://openshift/operators
+	opsRes := mcp.NewResource(
+		"cluster://openshift/operators",
+		"OpenShift Cluster Operators",
+		mcp.WithResourceDescription("ClusterVersion status and Available/Progressing/Degraded conditions of every ClusterOperator"),
+		mcp.WithMIMEType("application/json"),
+	)
+	srv.AddResource(opsRes, newOpenShiftOperatorsHandler(c))
+
 	// cluster://apigroups
 	groupsRes := mcp.NewResource(
 		"cluster://apigroups",
@@ -0,0 +1,141 @@
+package builds
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func formatBuildConfigsList(list *buildv1.BuildConfigList) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total BuildConfigs: %d\n\n", len(list.Items)))
+
+	for _, bc := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", bc.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", bc.Namespace))
+		sb.WriteString(fmt.Sprintf("Strategy: %s\n", strategyString(bc.Spec.Strategy)))
+		if git := bc.Spec.Source.Git; git != nil {
+			ref := git.Ref
+			if ref == "" {
+				ref = "<default branch>"
+			}
+			sb.WriteString(fmt.Sprintf("Source: %s (%s)\n", git.URI, ref))
+		} else if bc.Spec.Source.Type != "" {
+			sb.WriteString(fmt.Sprintf("Source: %s\n", bc.Spec.Source.Type))
+		}
+		if bc.Spec.Output.To != nil {
+			sb.WriteString(fmt.Sprintf("Output: %s\n", objectRefString(bc.Spec.Output.To)))
+		}
+		sb.WriteString(fmt.Sprintf("Triggers: %s\n", triggersSummary(bc.Spec.Triggers)))
+		sb.WriteString(fmt.Sprintf("Last Version: %d\n", bc.Status.LastVersion))
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatBuildsList(list *buildv1.BuildList, now time.Time) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total Builds: %d\n\n", len(list.Items)))
+
+	for _, build := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", build.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", build.Namespace))
+		status := string(build.Status.Phase)
+		if build.Status.Reason != "" {
+			status += fmt.Sprintf(" (%s)", build.Status.Reason)
+		}
+		sb.WriteString(fmt.Sprintf("Status: %s\n", status))
+		if build.Status.Message != "" && build.Status.Phase != buildv1.BuildPhaseComplete {
+			sb.WriteString(fmt.Sprintf("Message: %s\n", build.Status.Message))
+		}
+		if build.Status.StartTimestamp != nil {
+			sb.WriteString(fmt.Sprintf("Started: %s\n", build.Status.StartTimestamp.Format(time.RFC3339)))
+		}
+		if d := buildDuration(&build, now); d != "" {
+			sb.WriteString(fmt.Sprintf("Duration: %s\n", d))
+		}
+		if rev := build.Spec.Revision; rev != nil && rev.Git != nil && rev.Git.Commit != "" {
+			sb.WriteString(fmt.Sprintf("Commit: %s", shortCommit(rev.Git.Commit)))
+			if msg := firstLine(rev.Git.Message); msg != "" {
+				sb.WriteString(" " + msg)
+			}
+			sb.WriteString("\n")
+		}
+		if len(build.Spec.TriggeredBy) > 0 {
+			sb.WriteString(fmt.Sprintf("Triggered By: %s\n", build.Spec.TriggeredBy[0].Message))
+		}
+		if build.Status.OutputDockerImageReference != "" {
+			sb.WriteString(fmt.Sprintf("Output Image: %s\n", build.Status.OutputDockerImageReference))
+		}
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+// buildDuration usa status.duration quando o build terminou; em andamento, o tempo desde o início.
+func buildDuration(build *buildv1.Build, now time.Time) string {
+	switch {
+	case build.Status.Duration > 0:
+		return build.Status.Duration.Round(time.Second).String()
+	case build.Status.StartTimestamp != nil && build.Status.CompletionTimestamp != nil:
+		return build.Status.CompletionTimestamp.Sub(build.Status.StartTimestamp.Time).Round(time.Second).String()
+	case build.Status.StartTimestamp != nil && !buildFinished(build.Status.Phase):
+		return now.Sub(build.Status.StartTimestamp.Time).Round(time.Second).String() + " (running)"
+	}
+	return ""
+}
+
+func strategyString(s buildv1.BuildStrategy) string {
+	switch {
+	case s.SourceStrategy != nil:
+		return fmt.Sprintf("%s from %s", s.Type, objectRefString(&s.SourceStrategy.From))
+	case s.DockerStrategy != nil && s.DockerStrategy.From != nil:
+		return fmt.Sprintf("%s from %s", s.Type, objectRefString(s.DockerStrategy.From))
+	case s.CustomStrategy != nil:
+		return fmt.Sprintf("%s from %s", s.Type, objectRefString(&s.CustomStrategy.From))
+	}
+	return string(s.Type)
+}
+
+func triggersSummary(triggers []buildv1.BuildTriggerPolicy) string {
+	if len(triggers) == 0 {
+		return "<none>"
+	}
+	parts := make([]string, 0, len(triggers))
+	for _, t := range triggers {
+		parts = append(parts, string(t.Type))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func objectRefString(ref *corev1.ObjectReference) string {
+	name := ref.Name
+	if ref.Namespace != "" {
+		name = ref.Namespace + "/" + name
+	}
+	if ref.Kind == "" {
+		return name
+	}
+	return ref.Kind + " " + name
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 12 {
+		return commit[:12]
+	}
+	return commit
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
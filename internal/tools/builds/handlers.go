@@ -0,0 +1,349 @@
+package builds
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+var (
+	buildConfigsGVR = schema.GroupVersionResource{Group: "build.openshift.io", Version: "v1", Resource: "buildconfigs"}
+	buildsGVR       = schema.GroupVersionResource{Group: "build.openshift.io", Version: "v1", Resource: "builds"}
+)
+
+const (
+	// defaultFollowSeconds / maxFollowSeconds limitam quanto tempo get_build_logs fica em follow.
+	defaultFollowSeconds = 60
+	maxFollowSeconds     = 600
+
+	// maxLogBytes limita a saída de get_build_logs; o excedente mais antigo é descartado.
+	maxLogBytes = 256 * 1024
+
+	// gitCloneContainer é o init container do build pod que clona o repositório.
+	gitCloneContainer = "git-clone"
+)
+
+func newListBuildConfigsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		ul, err := c.Dynamic.Resource(buildConfigsGVR).Namespace(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list buildconfigs: %v", err)), nil
+		}
+
+		list := &buildv1.BuildConfigList{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(ul.UnstructuredContent(), list); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to decode buildconfigs: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatBuildConfigsList(list)), nil
+	}
+}
+
+// newStartBuildHandler equivale a oc start-build: chama o endpoint instantiate do BuildConfig.
+func newStartBuildHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		request := &buildv1.BuildRequest{
+			TypeMeta:    metav1.TypeMeta{APIVersion: buildv1.GroupVersion.String(), Kind: "BuildRequest"},
+			ObjectMeta:  metav1.ObjectMeta{Name: name},
+			TriggeredBy: []buildv1.BuildTriggerCause{{Message: "Manually triggered"}},
+		}
+		if ref := utils.GetStringArg(args, "commit", ""); ref != "" {
+			request.Revision = &buildv1.SourceRevision{
+				Type: buildv1.BuildSourceGit,
+				Git:  &buildv1.GitSourceRevision{Commit: ref},
+			}
+		}
+		env, err := envFromArgs(args["env"])
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		request.Env = env
+
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(request)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to encode build request: %v", err)), nil
+		}
+
+		out, err := c.Dynamic.Resource(buildConfigsGVR).Namespace(ns).Create(ctx, &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}, "instantiate")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to start build of buildconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("Build %s/%s started from buildconfig %s", ns, out.GetName(), name)
+		if request.Revision != nil {
+			msg += fmt.Sprintf(" at %s", request.Revision.Git.Commit)
+		}
+		if len(env) > 0 {
+			msg += fmt.Sprintf(" with %d env override(s)", len(env))
+		}
+		msg += fmt.Sprintf("\nUse get_build_logs with name %q to follow it.", out.GetName())
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+func newListBuildsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		selector := utils.GetStringArg(args, "labelSelector", "")
+		if bc := utils.GetStringArg(args, "buildConfig", ""); bc != "" {
+			if selector != "" {
+				selector += ","
+			}
+			selector += buildv1.BuildConfigLabel + "=" + bc
+		}
+
+		ul, err := buildClient(c, ns).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list builds: %v", err)), nil
+		}
+
+		list := &buildv1.BuildList{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(ul.UnstructuredContent(), list); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to decode builds: %v", err)), nil
+		}
+		sort.SliceStable(list.Items, func(i, j int) bool {
+			if list.Items[i].Namespace != list.Items[j].Namespace {
+				return list.Items[i].Namespace < list.Items[j].Namespace
+			}
+			return list.Items[i].CreationTimestamp.After(list.Items[j].CreationTimestamp.Time)
+		})
+		if limit := utils.GetIntArg(args, "limit", 20); limit > 0 && len(list.Items) > limit {
+			list.Items = list.Items[:limit]
+		}
+
+		return mcp.NewToolResultText(formatBuildsList(list, time.Now())), nil
+	}
+}
+
+// newGetBuildLogsHandler lê os logs do build pod. Com follow, acompanha o build até ele
+// terminar ou até timeoutSeconds, repassando as linhas como notifications/progress.
+func newGetBuildLogsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		follow := utils.GetBoolArg(args, "follow", false)
+		timeout := utils.GetIntArg(args, "timeoutSeconds", defaultFollowSeconds)
+		if timeout <= 0 || timeout > maxFollowSeconds {
+			return mcp.NewToolResultError(fmt.Sprintf("timeoutSeconds must be between 1 and %d", maxFollowSeconds)), nil
+		}
+
+		build, err := getBuild(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get build %s/%s: %v", ns, name, err)), nil
+		}
+		podName := build.Annotations[buildv1.BuildPodNameAnnotation]
+		if podName == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("build %s/%s has no build pod yet (phase %s)", ns, name, build.Status.Phase)), nil
+		}
+
+		opts := &corev1.PodLogOptions{
+			Container: utils.GetStringArg(args, "container", ""),
+			Follow:    follow,
+		}
+		// Falha ao clonar o repositório só aparece no log do init container
+		if opts.Container == "" && build.Status.Reason == buildv1.StatusReasonFetchSourceFailed {
+			opts.Container = gitCloneContainer
+		}
+		if tail := utils.GetIntArg(args, "tailLines", 0); tail > 0 {
+			t := int64(tail)
+			opts.TailLines = &t
+		}
+
+		streamCtx := ctx
+		if follow {
+			var cancel context.CancelFunc
+			streamCtx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+			defer cancel()
+		}
+
+		stream, err := c.Kubernetes.CoreV1().Pods(ns).GetLogs(podName, opts).Stream(streamCtx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get logs of build pod %s/%s: %v", ns, podName, err)), nil
+		}
+		defer stream.Close()
+
+		report := utils.ProgressReporter(ctx, req)
+		out := &tailBuffer{max: maxLogBytes}
+		scanner := bufio.NewScanner(stream)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			out.add(scanner.Text())
+			if follow {
+				report(scanner.Text())
+			}
+		}
+		timedOut := false
+		if err := scanner.Err(); err != nil {
+			if streamCtx.Err() == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to read logs of build pod %s/%s: %v", ns, podName, err)), nil
+			}
+			timedOut = true
+		}
+
+		// Fase atualizada: depois do follow o build normalmente já terminou
+		if latest, err := getBuild(ctx, c, ns, name); err == nil {
+			build = latest
+		}
+
+		header := fmt.Sprintf("Build %s/%s (pod %s", ns, name, podName)
+		if opts.Container != "" {
+			header += ", container " + opts.Container
+		}
+		header += fmt.Sprintf(") phase %s", build.Status.Phase)
+		if build.Status.Reason != "" {
+			header += fmt.Sprintf(" (%s)", build.Status.Reason)
+		}
+		if timedOut {
+			header += fmt.Sprintf("; stopped following after %ds", timeout)
+		}
+		return mcp.NewToolResultText(header + "\n\n" + out.String()), nil
+	}
+}
+
+// newCancelBuildHandler equivale a oc cancel-build: marca status.cancelled e o controller encerra o pod.
+func newCancelBuildHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		build, err := getBuild(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get build %s/%s: %v", ns, name, err)), nil
+		}
+		if buildFinished(build.Status.Phase) {
+			return mcp.NewToolResultError(fmt.Sprintf("build %s/%s already finished (phase %s)", ns, name, build.Status.Phase)), nil
+		}
+
+		patch := []byte(`{"status":{"cancelled":true}}`)
+		if _, err := buildClient(c, ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel build %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Build %s/%s cancelled (was %s)", ns, name, build.Status.Phase)), nil
+	}
+}
+
+func buildClient(c *clients.Clients, ns string) dynamic.ResourceInterface {
+	return c.Dynamic.Resource(buildsGVR).Namespace(ns)
+}
+
+func getBuild(ctx context.Context, c *clients.Clients, ns, name string) (*buildv1.Build, error) {
+	u, err := buildClient(c, ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	build := &buildv1.Build{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, build); err != nil {
+		return nil, err
+	}
+	return build, nil
+}
+
+func buildFinished(phase buildv1.BuildPhase) bool {
+	switch phase {
+	case buildv1.BuildPhaseComplete, buildv1.BuildPhaseFailed, buildv1.BuildPhaseError, buildv1.BuildPhaseCancelled:
+		return true
+	}
+	return false
+}
+
+// envFromArgs aceita env como objeto {"NOME": "valor"}.
+func envFromArgs(raw any) ([]corev1.EnvVar, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	m, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("env must be an object of NAME: value")
+	}
+	env := make([]corev1.EnvVar, 0, len(m))
+	for k, v := range m {
+		s, ok := v.(string)
+		if !ok {
+			s = fmt.Sprint(v)
+		}
+		env = append(env, corev1.EnvVar{Name: k, Value: s})
+	}
+	sort.Slice(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+	return env, nil
+}
+
+// tailBuffer guarda as últimas linhas até max bytes.
+type tailBuffer struct {
+	max     int
+	lines   []string
+	size    int
+	dropped int
+}
+
+func (b *tailBuffer) add(line string) {
+	b.lines = append(b.lines, line)
+	b.size += len(line) + 1
+	for b.size > b.max && len(b.lines) > 1 {
+		b.size -= len(b.lines[0]) + 1
+		b.lines = b.lines[1:]
+		b.dropped++
+	}
+}
+
+func (b *tailBuffer) String() string {
+	var sb strings.Builder
+	if b.dropped > 0 {
+		sb.WriteString(fmt.Sprintf("[... %d earlier line(s) omitted, output limited to %d bytes ...]\n", b.dropped, b.max))
+	}
+	for _, l := range b.lines {
+		sb.WriteString(l + "\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,134 @@
+package builds
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_buildconfigs",
+		Description: "List OpenShift BuildConfigs with strategy, git source, output image, triggers and last version",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list BuildConfigs from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter BuildConfigs",
+				},
+			},
+		},
+	}, newListBuildConfigsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "start_build",
+		Description: "Start a new Build from a BuildConfig (like oc start-build) and return the Build name",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the BuildConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the BuildConfig",
+				},
+				"commit": map[string]interface{}{
+					"type":        "string",
+					"description": "Git commit, branch or tag to build instead of the BuildConfig ref",
+				},
+				"env": map[string]interface{}{
+					"type":        "object",
+					"description": "Environment overrides for the build, as NAME: value",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newStartBuildHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_builds",
+		Description: "List OpenShift Builds, newest first, with status, reason, duration, commit and output image",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list Builds from (empty for all namespaces)",
+				},
+				"buildConfig": map[string]interface{}{
+					"type":        "string",
+					"description": "Only Builds of this BuildConfig",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter Builds",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of Builds to return (default 20, 0 for all)",
+				},
+			},
+		},
+	}, newListBuildsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "get_build_logs",
+		Description: "Get the logs of a Build from its build pod; with follow, stream them until the build ends or the timeout expires (lines are sent as progress notifications)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the Build",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the Build",
+				},
+				"follow": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Keep streaming while the build runs",
+				},
+				"timeoutSeconds": map[string]interface{}{
+					"type":        "integer",
+					"description": "How long to follow, in seconds (default 60, max 600)",
+				},
+				"tailLines": map[string]interface{}{
+					"type":        "integer",
+					"description": "Only the last N lines",
+				},
+				"container": map[string]interface{}{
+					"type":        "string",
+					"description": "Container of the build pod (default: the build container, or git-clone when fetching the source failed)",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newGetBuildLogsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "cancel_build",
+		Description: "Cancel a Build that is new, pending or running (like oc cancel-build)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the Build",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the Build",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newCancelBuildHandler(clients))
+}
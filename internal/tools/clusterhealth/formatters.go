@@ -0,0 +1,130 @@
+package clusterhealth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clusterstatus"
+)
+
+func formatReport(r *clusterstatus.Report, onlyUnhealthy bool, historyLimit int, now time.Time) string {
+	var sb strings.Builder
+
+	if v := r.Version; v != nil {
+		sb.WriteString("ClusterVersion:\n")
+		current := v.Current
+		if current == "" {
+			current = "<none completed>"
+		}
+		sb.WriteString(fmt.Sprintf("  Current: %s\n", current))
+		if v.Desired != v.Current {
+			sb.WriteString(fmt.Sprintf("  Desired: %s (%s)\n", v.Desired, v.DesiredImage))
+		}
+		if v.Channel != "" {
+			sb.WriteString(fmt.Sprintf("  Channel: %s\n", v.Channel))
+		} else {
+			sb.WriteString("  Channel: <none> (no update recommendations)\n")
+		}
+		sb.WriteString(fmt.Sprintf("  Cluster ID: %s\n", v.ClusterID))
+
+		if len(v.Conditions) > 0 {
+			sb.WriteString("\n  Conditions:\n")
+			for _, cond := range v.Conditions {
+				sb.WriteString(fmt.Sprintf("    %s: %s", cond.Type, cond.Status))
+				if cond.Reason != "" {
+					sb.WriteString(fmt.Sprintf(" (Reason: %s)", cond.Reason))
+				}
+				sb.WriteString(fmt.Sprintf(", since %s\n", age(cond.Since, now)))
+				if conditionNeedsMessage(cond) && cond.Message != "" {
+					sb.WriteString(fmt.Sprintf("      %s\n", indent(cond.Message, "      ")))
+				}
+			}
+		}
+
+		sb.WriteString("\n  Available Updates: ")
+		if len(v.AvailableUpdates) == 0 {
+			sb.WriteString("<none>\n")
+		} else {
+			sb.WriteString(strings.Join(v.AvailableUpdates, ", ") + "\n")
+		}
+		if len(v.ConditionalUpdates) > 0 {
+			sb.WriteString("  Conditional Updates (known risks):\n")
+			for _, cu := range v.ConditionalUpdates {
+				sb.WriteString(fmt.Sprintf("    %s: %s\n", cu.Version, strings.Join(cu.Risks, ", ")))
+			}
+		}
+
+		history := v.History
+		if historyLimit > 0 && len(history) > historyLimit {
+			history = history[:historyLimit]
+		}
+		if len(history) > 0 {
+			sb.WriteString(fmt.Sprintf("\n  Update History (%d of %d):\n", len(history), len(v.History)))
+			for _, h := range history {
+				sb.WriteString(fmt.Sprintf("    %s %s, started %s", h.Version, h.State, h.Started.Format(time.RFC3339)))
+				if h.Completed != nil {
+					sb.WriteString(fmt.Sprintf(", took %s", h.Completed.Sub(h.Started).Round(time.Second)))
+				}
+				if !h.Verified {
+					sb.WriteString(", not verified")
+				}
+				sb.WriteString("\n")
+			}
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\nClusterOperators: %d total, %d available, %d degraded or unavailable\n",
+		len(r.Operators), r.Available, len(r.Degraded)))
+	if len(r.Degraded) > 0 {
+		sb.WriteString(fmt.Sprintf("Unhealthy: %s\n", strings.Join(r.Degraded, ", ")))
+	}
+	sb.WriteString("\n")
+
+	for _, op := range r.Operators {
+		healthy := op.Healthy()
+		if onlyUnhealthy && healthy {
+			continue
+		}
+		marker := "  "
+		if !healthy {
+			marker = "! "
+		}
+		sb.WriteString(fmt.Sprintf("%s%s: Available=%s Progressing=%s Degraded=%s",
+			marker, op.Name, op.Available, op.Progressing, op.Degraded))
+		if op.Version != "" {
+			sb.WriteString(fmt.Sprintf(" (%s)", op.Version))
+		}
+		sb.WriteString("\n")
+		if !healthy && op.Message != "" {
+			since := ""
+			if op.Since != nil {
+				since = fmt.Sprintf(" [since %s]", age(*op.Since, now))
+			}
+			sb.WriteString(fmt.Sprintf("    %s%s\n", indent(op.Message, "    "), since))
+		}
+	}
+
+	return sb.String()
+}
+
+// conditionNeedsMessage: só vale mostrar a mensagem quando a condição indica problema.
+func conditionNeedsMessage(c clusterstatus.Condition) bool {
+	switch c.Type {
+	case "Failing", "Progressing":
+		return c.Status == "True"
+	default:
+		return c.Status != "True"
+	}
+}
+
+func indent(s, prefix string) string {
+	return strings.ReplaceAll(strings.TrimSpace(s), "\n", "\n"+prefix)
+}
+
+func age(t, now time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return now.Sub(t).Round(time.Second).String() + " ago"
+}
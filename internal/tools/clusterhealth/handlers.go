@@ -0,0 +1,37 @@
+package clusterhealth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/clusterstatus"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+)
+
+// defaultHistoryLimit é quantas entradas do histórico de updates cluster_health mostra.
+const defaultHistoryLimit = 5
+
+func newClusterHealthHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+
+		historyLimit := utils.GetIntArg(args, "historyLimit", defaultHistoryLimit)
+		if historyLimit < 0 {
+			return mcp.NewToolResultError("historyLimit must be >= 0"), nil
+		}
+
+		report, err := clusterstatus.Collect(ctx, c)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get cluster health: %v", err)), nil
+		}
+		if !report.OpenShift {
+			return mcp.NewToolResultText("config.openshift.io ClusterVersion not found: this does not look like an OpenShift 4 cluster.\n"), nil
+		}
+
+		return mcp.NewToolResultText(formatReport(report, utils.GetBoolArg(args, "onlyUnhealthy", false), historyLimit, time.Now())), nil
+	}
+}
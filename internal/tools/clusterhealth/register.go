@@ -0,0 +1,27 @@
+package clusterhealth
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "cluster_health",
+		Description: "OpenShift cluster health: ClusterVersion (current/desired version, channel, update history, available updates, Upgradeable/Failing conditions) and the Available/Progressing/Degraded conditions of every ClusterOperator, with degraded operators and their messages first",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"onlyUnhealthy": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Only list operators that are degraded or not available",
+				},
+				"historyLimit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of update history entries to show (default 5, 0 for all)",
+				},
+			},
+		},
+	}, newClusterHealthHandler(clients))
+}
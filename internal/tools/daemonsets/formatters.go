@@ -0,0 +1,103 @@
+package daemonsets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func formatDaemonSetsList(list *appsv1.DaemonSetList) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total DaemonSets: %d\n\n", len(list.Items)))
+
+	for _, ds := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", ds.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", ds.Namespace))
+		sb.WriteString(fmt.Sprintf("Desired: %d, Current: %d, Ready: %d, Updated: %d, Available: %d, Misscheduled: %d\n",
+			ds.Status.DesiredNumberScheduled, ds.Status.CurrentNumberScheduled, ds.Status.NumberReady,
+			ds.Status.UpdatedNumberScheduled, ds.Status.NumberAvailable, ds.Status.NumberMisscheduled))
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatDaemonSetDetails(ds *appsv1.DaemonSet, nodes []nodeStatus) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("DaemonSet: %s\n", ds.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", ds.Namespace))
+	sb.WriteString(fmt.Sprintf("Desired: %d\nCurrent: %d\nReady: %d\nUpdated: %d\nAvailable: %d\nMisscheduled: %d\n",
+		ds.Status.DesiredNumberScheduled, ds.Status.CurrentNumberScheduled, ds.Status.NumberReady,
+		ds.Status.UpdatedNumberScheduled, ds.Status.NumberAvailable, ds.Status.NumberMisscheduled))
+
+	sb.WriteString(fmt.Sprintf("Update Strategy: %s\n", ds.Spec.UpdateStrategy.Type))
+	if ru := ds.Spec.UpdateStrategy.RollingUpdate; ru != nil {
+		if ru.MaxUnavailable != nil {
+			sb.WriteString(fmt.Sprintf("  Max Unavailable: %s\n", ru.MaxUnavailable.String()))
+		}
+		if ru.MaxSurge != nil {
+			sb.WriteString(fmt.Sprintf("  Max Surge: %s\n", ru.MaxSurge.String()))
+		}
+	}
+
+	if len(ds.Spec.Template.Spec.NodeSelector) > 0 {
+		sb.WriteString("\nNode Selector:\n")
+		for k, v := range ds.Spec.Template.Spec.NodeSelector {
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", k, v))
+		}
+	}
+
+	sb.WriteString("\nContainers:\n")
+	for _, container := range ds.Spec.Template.Spec.Containers {
+		sb.WriteString(fmt.Sprintf("  Name: %s\n", container.Name))
+		sb.WriteString(fmt.Sprintf("  Image: %s\n", container.Image))
+	}
+
+	var running, missing, misscheduled, skipped []string
+	for _, st := range nodes {
+		switch {
+		case st.eligible && len(st.pods) == 0:
+			missing = append(missing, st.node)
+		case !st.eligible && len(st.pods) > 0:
+			for _, pod := range st.pods {
+				misscheduled = append(misscheduled, fmt.Sprintf("%s: %s (%s)", st.node, pod.Name, st.reason))
+			}
+		case len(st.pods) > 0:
+			for _, pod := range st.pods {
+				running = append(running, fmt.Sprintf("%s: %s %s, ready %s, restarts %d", st.node, pod.Name, utils.PodPhase(pod), utils.PodReadyCount(pod), utils.PodRestartCount(pod)))
+			}
+		default:
+			skipped = append(skipped, fmt.Sprintf("%s (%s)", st.node, st.reason))
+		}
+	}
+
+	writeSection(&sb, "Pods by Node", running)
+	writeSection(&sb, "Eligible Nodes Without a Pod", missing)
+	writeSection(&sb, "Misscheduled Pods", misscheduled)
+	writeSection(&sb, "Nodes Not Targeted", skipped)
+	sb.WriteString("\n(node eligibility is estimated from nodeSelector, required node affinity and taints)\n")
+
+	if len(ds.Status.Conditions) > 0 {
+		sb.WriteString("\nConditions:\n")
+		for _, cond := range ds.Status.Conditions {
+			sb.WriteString(fmt.Sprintf("  %s: %s (Reason: %s)\n", cond.Type, cond.Status, cond.Reason))
+		}
+	}
+
+	return sb.String()
+}
+
+func writeSection(sb *strings.Builder, title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n%s (%d):\n", title, len(lines)))
+	for _, l := range lines {
+		sb.WriteString("  " + l + "\n")
+	}
+}
@@ -0,0 +1,250 @@
+package daemonsets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newListDaemonSetsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		list, err := c.Kubernetes.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list daemonsets: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatDaemonSetsList(list)), nil
+	}
+}
+
+func newGetDaemonSetHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		ds, err := c.Kubernetes.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get daemonset %s/%s: %v", ns, name, err)), nil
+		}
+
+		nodes, err := daemonSetNodes(ctx, c, ds)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to compute node status of daemonset %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(formatDaemonSetDetails(ds, nodes)), nil
+	}
+}
+
+func newRestartDaemonSetHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`,
+			time.Now().Format(time.RFC3339))
+		ds, err := c.Kubernetes.AppsV1().DaemonSets(ns).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to restart daemonset %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("DaemonSet %s/%s restarted", ns, name)
+		if ds.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			msg += "\nupdateStrategy is OnDelete: pods are only restarted when you delete them."
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+// nodeStatus é a situação do DaemonSet em um node.
+type nodeStatus struct {
+	node     string
+	eligible bool // o node casa com nodeSelector/affinity de node e o pod tolera os taints
+	reason   string
+	pods     []*corev1.Pod
+}
+
+// daemonSetNodes cruza nodes e pods do DaemonSet. A elegibilidade é uma aproximação do
+// scheduler (nodeSelector, affinity de node obrigatória e taints NoSchedule/NoExecute).
+func daemonSetNodes(ctx context.Context, c *clients.Clients, ds *appsv1.DaemonSet) ([]nodeStatus, error) {
+	nodes, err := c.Kubernetes.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.Kubernetes.CoreV1().Pods(ds.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	byNode := map[string]*nodeStatus{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		eligible, reason := nodeEligible(&ds.Spec.Template.Spec, node)
+		byNode[node.Name] = &nodeStatus{node: node.Name, eligible: eligible, reason: reason}
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if owner := metav1.GetControllerOf(pod); owner == nil || owner.UID != ds.UID {
+			continue
+		}
+		st, ok := byNode[pod.Spec.NodeName]
+		if !ok {
+			st = &nodeStatus{node: pod.Spec.NodeName, reason: "node not found"}
+			byNode[pod.Spec.NodeName] = st
+		}
+		st.pods = append(st.pods, pod)
+	}
+
+	out := make([]nodeStatus, 0, len(byNode))
+	for _, st := range byNode {
+		out = append(out, *st)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].node < out[j].node })
+	return out, nil
+}
+
+// daemonSetDefaultTolerations são os taints que o controller de DaemonSet tolera automaticamente.
+var daemonSetDefaultTolerations = map[string]bool{
+	corev1.TaintNodeNotReady:           true,
+	corev1.TaintNodeUnreachable:        true,
+	corev1.TaintNodeDiskPressure:       true,
+	corev1.TaintNodeMemoryPressure:     true,
+	corev1.TaintNodePIDPressure:        true,
+	corev1.TaintNodeUnschedulable:      true,
+	corev1.TaintNodeNetworkUnavailable: true,
+}
+
+func nodeEligible(spec *corev1.PodSpec, node *corev1.Node) (bool, string) {
+	if len(spec.NodeSelector) > 0 && !labels.SelectorFromSet(spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false, "nodeSelector does not match"
+	}
+
+	if aff := spec.Affinity; aff != nil && aff.NodeAffinity != nil && aff.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		matched := false
+		for _, term := range aff.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			if nodeMatchesTerm(node, term) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, "required node affinity does not match"
+		}
+	}
+
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule || daemonSetDefaultTolerations[taint.Key] {
+			continue
+		}
+		tolerated := false
+		for _, tol := range spec.Tolerations {
+			if tol.ToleratesTaint(&taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false, fmt.Sprintf("taint %s=%s:%s not tolerated", taint.Key, taint.Value, taint.Effect)
+		}
+	}
+	return true, ""
+}
+
+// nodeMatchesTerm avalia matchExpressions sobre os labels do node (matchFields é ignorado).
+func nodeMatchesTerm(node *corev1.Node, term corev1.NodeSelectorTerm) bool {
+	for _, expr := range term.MatchExpressions {
+		value, exists := node.Labels[expr.Key]
+		switch expr.Operator {
+		case corev1.NodeSelectorOpIn:
+			if !exists || !contains(expr.Values, value) {
+				return false
+			}
+		case corev1.NodeSelectorOpNotIn:
+			if exists && contains(expr.Values, value) {
+				return false
+			}
+		case corev1.NodeSelectorOpExists:
+			if !exists {
+				return false
+			}
+		case corev1.NodeSelectorOpDoesNotExist:
+			if exists {
+				return false
+			}
+		case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+			if !exists || !compareNodeLabel(expr, value) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// compareNodeLabel aplica Gt/Lt como o scheduler: label e valor (único) são inteiros;
+// se algum não for, a expressão não casa.
+func compareNodeLabel(expr corev1.NodeSelectorRequirement, value string) bool {
+	if len(expr.Values) != 1 {
+		return false
+	}
+	label, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	want, err := strconv.ParseInt(expr.Values[0], 10, 64)
+	if err != nil {
+		return false
+	}
+	if expr.Operator == corev1.NodeSelectorOpGt {
+		return label > want
+	}
+	return label < want
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
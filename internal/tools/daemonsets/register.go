@@ -0,0 +1,65 @@
+package daemonsets
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_daemonsets",
+		Description: "List DaemonSets with desired/current/ready/updated/misscheduled counts",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list DaemonSets from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter DaemonSets",
+				},
+			},
+		},
+	}, newListDaemonSetsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "get_daemonset",
+		Description: "Get a DaemonSet with its status per node: the pod on each node, nodes that should run a pod but have none, and misscheduled pods",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DaemonSet",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DaemonSet",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newGetDaemonSetHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "restart_daemonset",
+		Description: "Restart a DaemonSet by updating its pod template annotation",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DaemonSet",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DaemonSet",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRestartDaemonSetHandler(clients))
+}
@@ -0,0 +1,177 @@
+package deploymentconfigs
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func formatDeploymentConfigsList(list *appsv1.DeploymentConfigList) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total DeploymentConfigs: %d\n\n", len(list.Items)))
+
+	for _, dc := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", dc.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", dc.Namespace))
+		sb.WriteString(fmt.Sprintf("Replicas: %d/%d\n", dc.Status.ReadyReplicas, dc.Spec.Replicas))
+		sb.WriteString(fmt.Sprintf("Latest Version: %d\n", dc.Status.LatestVersion))
+		sb.WriteString(fmt.Sprintf("Triggers: %s\n", triggersSummary(dc.Spec.Triggers)))
+		if dc.Spec.Paused {
+			sb.WriteString("Paused: true\n")
+		}
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatDeploymentConfigDetails(dc *appsv1.DeploymentConfig, rcs []corev1.ReplicationController) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("DeploymentConfig: %s\n", dc.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", dc.Namespace))
+	sb.WriteString(fmt.Sprintf("Latest Version: %d\n", dc.Status.LatestVersion))
+	sb.WriteString(fmt.Sprintf("Replicas: %d desired, %d current, %d ready, %d updated, %d available\n",
+		dc.Spec.Replicas, dc.Status.Replicas, dc.Status.ReadyReplicas, dc.Status.UpdatedReplicas, dc.Status.AvailableReplicas))
+	sb.WriteString(fmt.Sprintf("Strategy: %s\n", dc.Spec.Strategy.Type))
+	if dc.Spec.Paused {
+		sb.WriteString("Paused: true\n")
+	}
+	if dc.Spec.Test {
+		sb.WriteString("Test: true (scaled to zero after each successful rollout)\n")
+	}
+	sb.WriteString(fmt.Sprintf("Triggers: %s\n", triggersSummary(dc.Spec.Triggers)))
+
+	if len(dc.Spec.Selector) > 0 {
+		sb.WriteString("\nSelector:\n")
+		for k, v := range dc.Spec.Selector {
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", k, v))
+		}
+	}
+
+	if dc.Spec.Template != nil {
+		sb.WriteString("\nContainers:\n")
+		for _, container := range dc.Spec.Template.Spec.Containers {
+			sb.WriteString(fmt.Sprintf("  Name: %s\n", container.Name))
+			sb.WriteString(fmt.Sprintf("  Image: %s\n", container.Image))
+		}
+	}
+
+	if len(dc.Status.Conditions) > 0 {
+		sb.WriteString("\nConditions:\n")
+		for _, cond := range dc.Status.Conditions {
+			sb.WriteString(fmt.Sprintf("  %s: %s (Reason: %s)\n", cond.Type, cond.Status, cond.Reason))
+		}
+	}
+
+	sb.WriteString("\nRevisions:\n")
+	if len(rcs) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for i := range rcs {
+		rc := &rcs[i]
+		desired := int32(0)
+		if rc.Spec.Replicas != nil {
+			desired = *rc.Spec.Replicas
+		}
+		sb.WriteString(fmt.Sprintf("  %d: %s %s, replicas %d/%d\n", rcVersion(rc), rc.Name, rcPhase(rc), rc.Status.ReadyReplicas, desired))
+	}
+
+	return sb.String()
+}
+
+func formatDeploymentConfigStatus(dc *appsv1.DeploymentConfig, rc *corev1.ReplicationController, deployer *corev1.Pod) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("DeploymentConfig: %s\n", dc.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", dc.Namespace))
+	if dc.Spec.Paused {
+		sb.WriteString("Paused: true (triggers do not start new rollouts)\n")
+	}
+
+	sb.WriteString("\nTriggers:\n")
+	if len(dc.Spec.Triggers) == 0 {
+		sb.WriteString("  <none> (rollouts only start with rollout_latest)\n")
+	}
+	for _, t := range dc.Spec.Triggers {
+		switch {
+		case t.Type == appsv1.DeploymentTriggerOnImageChange && t.ImageChangeParams != nil:
+			p := t.ImageChangeParams
+			from := p.From.Name
+			if p.From.Namespace != "" {
+				from = p.From.Namespace + "/" + from
+			}
+			sb.WriteString(fmt.Sprintf("  ImageChange: %s %s, automatic %t, containers %s\n",
+				p.From.Kind, from, p.Automatic, strings.Join(p.ContainerNames, ",")))
+			if p.LastTriggeredImage != "" {
+				sb.WriteString(fmt.Sprintf("    Last Triggered Image: %s\n", p.LastTriggeredImage))
+			}
+		default:
+			sb.WriteString(fmt.Sprintf("  %s\n", t.Type))
+		}
+	}
+
+	if d := dc.Status.Details; d != nil {
+		sb.WriteString("\nLatest Rollout Cause:\n")
+		if d.Message != "" {
+			sb.WriteString(fmt.Sprintf("  Message: %s\n", d.Message))
+		}
+		for _, cause := range d.Causes {
+			if cause.ImageTrigger != nil {
+				sb.WriteString(fmt.Sprintf("  %s: %s %s\n", cause.Type, cause.ImageTrigger.From.Kind, cause.ImageTrigger.From.Name))
+			} else {
+				sb.WriteString(fmt.Sprintf("  %s\n", cause.Type))
+			}
+		}
+	}
+
+	sb.WriteString("\nLatest ReplicationController:\n")
+	if rc == nil {
+		sb.WriteString("  <none> (never rolled out)\n")
+		return sb.String()
+	}
+	desired := int32(0)
+	if rc.Spec.Replicas != nil {
+		desired = *rc.Spec.Replicas
+	}
+	sb.WriteString(fmt.Sprintf("  Name: %s\n", rc.Name))
+	sb.WriteString(fmt.Sprintf("  Revision: %d\n", rcVersion(rc)))
+	sb.WriteString(fmt.Sprintf("  Phase: %s\n", rcPhase(rc)))
+	if reason := rc.Annotations[appsv1.DeploymentStatusReasonAnnotation]; reason != "" {
+		sb.WriteString(fmt.Sprintf("  Reason: %s\n", reason))
+	}
+	if rc.Annotations[appsv1.DeploymentCancelledAnnotation] == "true" {
+		sb.WriteString("  Cancelled: true\n")
+	}
+	sb.WriteString(fmt.Sprintf("  Replicas: %d desired, %d current, %d ready, %d available\n",
+		desired, rc.Status.Replicas, rc.Status.ReadyReplicas, rc.Status.AvailableReplicas))
+	if deployer != nil {
+		sb.WriteString(fmt.Sprintf("  Deployer Pod: %s (%s)\n", deployer.Name, deployer.Status.Phase))
+	} else if name := rc.Annotations[appsv1.DeploymentPodAnnotation]; name != "" {
+		sb.WriteString(fmt.Sprintf("  Deployer Pod: %s (deleted)\n", name))
+	}
+
+	return sb.String()
+}
+
+func triggersSummary(triggers appsv1.DeploymentTriggerPolicies) string {
+	if len(triggers) == 0 {
+		return "<none>"
+	}
+	parts := make([]string, 0, len(triggers))
+	for _, t := range triggers {
+		if t.Type == appsv1.DeploymentTriggerOnImageChange && t.ImageChangeParams != nil {
+			auto := ""
+			if !t.ImageChangeParams.Automatic {
+				auto = ", manual"
+			}
+			parts = append(parts, fmt.Sprintf("ImageChange(%s%s)", t.ImageChangeParams.From.Name, auto))
+			continue
+		}
+		parts = append(parts, string(t.Type))
+	}
+	return strings.Join(parts, ", ")
+}
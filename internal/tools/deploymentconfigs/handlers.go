@@ -0,0 +1,438 @@
+package deploymentconfigs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+var deploymentConfigsGVR = schema.GroupVersionResource{Group: "apps.openshift.io", Version: "v1", Resource: "deploymentconfigs"}
+
+const (
+	// cancelledByUser é o status-reason gravado por oc rollout cancel.
+	cancelledByUser = "cancelled by the user"
+
+	// deploymentConfigLabel identifica os ReplicationControllers de um DC.
+	deploymentConfigLabel = "openshift.io/deployment-config.name"
+)
+
+func newListDeploymentConfigsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		ul, err := deploymentConfigClient(c, ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list deploymentconfigs: %v", err)), nil
+		}
+
+		list := &appsv1.DeploymentConfigList{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(ul.UnstructuredContent(), list); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to decode deploymentconfigs: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatDeploymentConfigsList(list)), nil
+	}
+}
+
+func newGetDeploymentConfigHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ns, name, errResult := nameAndNamespace(req)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		dc, err := getDeploymentConfig(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		rcs, err := deploymentConfigRCs(ctx, c, dc)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list replicationcontrollers of deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(formatDeploymentConfigDetails(dc, rcs)), nil
+	}
+}
+
+func newScaleDeploymentConfigHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ns, name, errResult := nameAndNamespace(req)
+		if errResult != nil {
+			return errResult, nil
+		}
+		args := req.Params.Arguments.(map[string]any)
+		if _, ok := args["replicas"]; !ok {
+			return mcp.NewToolResultError("replicas is required"), nil
+		}
+		replicas := utils.GetIntArg(args, "replicas", 0)
+		if replicas < 0 {
+			return mcp.NewToolResultError("replicas must be >= 0"), nil
+		}
+
+		dc, err := getDeploymentConfig(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+		previous := dc.Spec.Replicas
+
+		patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+		if _, err := deploymentConfigClient(c, ns).Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to scale deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("DeploymentConfig %s/%s scaled from %d to %d replicas", ns, name, previous, replicas)), nil
+	}
+}
+
+// newRolloutLatestHandler equivale a oc rollout latest: pede uma nova revisão ao endpoint instantiate.
+func newRolloutLatestHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ns, name, errResult := nameAndNamespace(req)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		request := &appsv1.DeploymentRequest{
+			TypeMeta: metav1.TypeMeta{APIVersion: appsv1.GroupVersion.String(), Kind: "DeploymentRequest"},
+			Name:     name,
+			Latest:   true,
+			Force:    true,
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(request)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to encode deployment request: %v", err)), nil
+		}
+
+		out, err := deploymentConfigClient(c, ns).Create(ctx, &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}, "instantiate")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to start rollout of deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		version, _, _ := unstructured.NestedInt64(out.Object, "status", "latestVersion")
+		return mcp.NewToolResultText(fmt.Sprintf("DeploymentConfig %s/%s rolled out: revision %d (replicationcontroller %s)",
+			ns, name, version, rcName(name, version))), nil
+	}
+}
+
+// newRolloutCancelHandler equivale a oc rollout cancel: marca a revisão em andamento como cancelada.
+func newRolloutCancelHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ns, name, errResult := nameAndNamespace(req)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		dc, err := getDeploymentConfig(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+		rc, err := latestRC(ctx, c, dc)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get latest replicationcontroller of deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		switch phase := rcPhase(rc); phase {
+		case appsv1.DeploymentStatusComplete, appsv1.DeploymentStatusFailed:
+			return mcp.NewToolResultError(fmt.Sprintf("no rollout in progress: revision %d is %s", dc.Status.LatestVersion, phase)), nil
+		}
+		if rc.Annotations[appsv1.DeploymentCancelledAnnotation] == "true" {
+			return mcp.NewToolResultText(fmt.Sprintf("Rollout %s is already being cancelled", rc.Name)), nil
+		}
+
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:"true",%q:%q}}}`,
+			appsv1.DeploymentCancelledAnnotation, appsv1.DeploymentStatusReasonAnnotation, cancelledByUser)
+		if _, err := c.Kubernetes.CoreV1().ReplicationControllers(ns).Patch(ctx, rc.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel rollout %s: %v", rc.Name, err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Cancelled rollout of deploymentconfig %s/%s (revision %d, replicationcontroller %s); the previous revision will be scaled back up",
+			ns, name, dc.Status.LatestVersion, rc.Name)), nil
+	}
+}
+
+// newRolloutRetryHandler equivale a oc rollout retry: remove os deployer pods da revisão com
+// falha e volta a fase para New, para o controller reexecutar o deployer.
+func newRolloutRetryHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ns, name, errResult := nameAndNamespace(req)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		dc, err := getDeploymentConfig(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+		rc, err := latestRC(ctx, c, dc)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get latest replicationcontroller of deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+		if phase := rcPhase(rc); phase != appsv1.DeploymentStatusFailed {
+			return mcp.NewToolResultError(fmt.Sprintf("revision %d is %s; only failed rollouts can be retried", dc.Status.LatestVersion, phase)), nil
+		}
+
+		pods, err := c.Kubernetes.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: appsv1.DeployerPodForDeploymentLabel + "=" + rc.Name,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list deployer pods of %s: %v", rc.Name, err)), nil
+		}
+		for _, pod := range pods.Items {
+			if err := c.Kubernetes.CoreV1().Pods(ns).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to delete deployer pod %s: %v", pod.Name, err)), nil
+			}
+		}
+
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q,%q:null,%q:null}}}`,
+			appsv1.DeploymentStatusAnnotation, appsv1.DeploymentStatusNew,
+			appsv1.DeploymentStatusReasonAnnotation, appsv1.DeploymentCancelledAnnotation)
+		if _, err := c.Kubernetes.CoreV1().ReplicationControllers(ns).Patch(ctx, rc.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retry rollout %s: %v", rc.Name, err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Retrying rollout of deploymentconfig %s/%s (revision %d, replicationcontroller %s); %d deployer pod(s) deleted",
+			ns, name, dc.Status.LatestVersion, rc.Name, len(pods.Items))), nil
+	}
+}
+
+// newRollbackDeploymentConfigHandler equivale a oc rollback: o endpoint rollback gera a
+// configuração com o template da revisão escolhida, os triggers de imagem automáticos são
+// desligados (senão a imagem antiga seria trocada de novo) e o resultado é gravado no DC.
+func newRollbackDeploymentConfigHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ns, name, errResult := nameAndNamespace(req)
+		if errResult != nil {
+			return errResult, nil
+		}
+		args := req.Params.Arguments.(map[string]any)
+		dryRun := utils.GetBoolArg(args, "dryRun", false)
+
+		dc, err := getDeploymentConfig(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		toVersion := int64(utils.GetIntArg(args, "toVersion", 0))
+		if toVersion == 0 {
+			toVersion = dc.Status.LatestVersion - 1
+		}
+		if toVersion < 1 || toVersion >= dc.Status.LatestVersion {
+			return mcp.NewToolResultError(fmt.Sprintf("toVersion must be between 1 and %d (latest is %d)", dc.Status.LatestVersion-1, dc.Status.LatestVersion)), nil
+		}
+		target := rcName(name, toVersion)
+		if _, err := c.Kubernetes.CoreV1().ReplicationControllers(ns).Get(ctx, target, metav1.GetOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get replicationcontroller %s of revision %d: %v", target, toVersion, err)), nil
+		}
+
+		rollback := &appsv1.DeploymentConfigRollback{
+			TypeMeta: metav1.TypeMeta{APIVersion: appsv1.GroupVersion.String(), Kind: "DeploymentConfigRollback"},
+			Name:     name,
+			Spec: appsv1.DeploymentConfigRollbackSpec{
+				From:            corev1.ObjectReference{Name: target},
+				Revision:        toVersion,
+				IncludeTemplate: true,
+			},
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(rollback)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to encode rollback request: %v", err)), nil
+		}
+
+		res := deploymentConfigClient(c, ns)
+		out, err := res.Create(ctx, &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}, "rollback")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to generate rollback of deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+		rolled := &appsv1.DeploymentConfig{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(out.Object, rolled); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to decode rollback of deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		disabled := disableImageTriggers(rolled)
+		changes := imageChanges(dc.Spec.Template, rolled.Spec.Template)
+
+		msg := fmt.Sprintf("DeploymentConfig %s/%s rolled back to revision %d", ns, name, toVersion)
+		if dryRun {
+			msg = fmt.Sprintf("DeploymentConfig %s/%s would be rolled back to revision %d (dry run)", ns, name, toVersion)
+		} else {
+			updated, err := runtime.DefaultUnstructuredConverter.ToUnstructured(rolled)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to encode deploymentconfig %s/%s: %v", ns, name, err)), nil
+			}
+			u := &unstructured.Unstructured{Object: updated}
+			u.SetAPIVersion(appsv1.GroupVersion.String())
+			u.SetKind("DeploymentConfig")
+			if _, err := res.Update(ctx, u, metav1.UpdateOptions{}); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to roll back deploymentconfig %s/%s: %v", ns, name, err)), nil
+			}
+		}
+
+		if len(changes) > 0 {
+			msg += "\nImage changes:"
+			for _, ch := range changes {
+				msg += "\n  " + ch
+			}
+		}
+		if !hasConfigChangeTrigger(rolled) {
+			msg += "\nThe deploymentconfig has no ConfigChange trigger: run rollout_latest to deploy the rolled back template."
+		}
+		if len(disabled) > 0 {
+			msg += fmt.Sprintf("\nWarning: automatic image change triggers were disabled for %v so the rollback is not undone by the next image push; re-enable them when ready.", disabled)
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+func newDeploymentConfigStatusHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ns, name, errResult := nameAndNamespace(req)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		dc, err := getDeploymentConfig(ctx, c, ns, name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deploymentconfig %s/%s: %v", ns, name, err)), nil
+		}
+
+		var rc *corev1.ReplicationController
+		var deployer *corev1.Pod
+		if dc.Status.LatestVersion > 0 {
+			if rc, err = latestRC(ctx, c, dc); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get latest replicationcontroller of deploymentconfig %s/%s: %v", ns, name, err)), nil
+			}
+			if podName := rc.Annotations[appsv1.DeploymentPodAnnotation]; podName != "" {
+				// O deployer pod pode já ter sido removido; nesse caso só não é mostrado
+				deployer, _ = c.Kubernetes.CoreV1().Pods(ns).Get(ctx, podName, metav1.GetOptions{})
+			}
+		}
+
+		return mcp.NewToolResultText(formatDeploymentConfigStatus(dc, rc, deployer)), nil
+	}
+}
+
+func nameAndNamespace(req mcp.CallToolRequest) (string, string, *mcp.CallToolResult) {
+	args, ok := req.Params.Arguments.(map[string]any)
+	if !ok {
+		return "", "", mcp.NewToolResultError("invalid arguments payload (expected object)")
+	}
+	name := utils.GetStringArg(args, "name", "")
+	ns := utils.GetStringArg(args, "namespace", "")
+	if name == "" || ns == "" {
+		return "", "", mcp.NewToolResultError("name and namespace are required")
+	}
+	return ns, name, nil
+}
+
+func deploymentConfigClient(c *clients.Clients, ns string) dynamic.ResourceInterface {
+	return c.Dynamic.Resource(deploymentConfigsGVR).Namespace(ns)
+}
+
+func getDeploymentConfig(ctx context.Context, c *clients.Clients, ns, name string) (*appsv1.DeploymentConfig, error) {
+	u, err := deploymentConfigClient(c, ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	dc := &appsv1.DeploymentConfig{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, dc); err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+// rcName é o nome do ReplicationController de uma revisão: <dc>-<versão>.
+func rcName(dc string, version int64) string {
+	return fmt.Sprintf("%s-%d", dc, version)
+}
+
+func latestRC(ctx context.Context, c *clients.Clients, dc *appsv1.DeploymentConfig) (*corev1.ReplicationController, error) {
+	if dc.Status.LatestVersion == 0 {
+		return nil, fmt.Errorf("deploymentconfig has not been rolled out yet")
+	}
+	return c.Kubernetes.CoreV1().ReplicationControllers(dc.Namespace).Get(ctx, rcName(dc.Name, dc.Status.LatestVersion), metav1.GetOptions{})
+}
+
+// deploymentConfigRCs lista as revisões do DC, da mais recente para a mais antiga.
+func deploymentConfigRCs(ctx context.Context, c *clients.Clients, dc *appsv1.DeploymentConfig) ([]corev1.ReplicationController, error) {
+	list, err := c.Kubernetes.CoreV1().ReplicationControllers(dc.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: deploymentConfigLabel + "=" + dc.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rcs := list.Items
+	sort.Slice(rcs, func(i, j int) bool { return rcVersion(&rcs[i]) > rcVersion(&rcs[j]) })
+	return rcs, nil
+}
+
+func rcVersion(rc *corev1.ReplicationController) int64 {
+	v, _ := strconv.ParseInt(rc.Annotations[appsv1.DeploymentVersionAnnotation], 10, 64)
+	return v
+}
+
+func rcPhase(rc *corev1.ReplicationController) appsv1.DeploymentStatus {
+	if phase := rc.Annotations[appsv1.DeploymentStatusAnnotation]; phase != "" {
+		return appsv1.DeploymentStatus(phase)
+	}
+	return appsv1.DeploymentStatusNew
+}
+
+// disableImageTriggers desliga os triggers de imagem automáticos e devolve os containers afetados.
+func disableImageTriggers(dc *appsv1.DeploymentConfig) []string {
+	var containers []string
+	for i := range dc.Spec.Triggers {
+		params := dc.Spec.Triggers[i].ImageChangeParams
+		if dc.Spec.Triggers[i].Type != appsv1.DeploymentTriggerOnImageChange || params == nil || !params.Automatic {
+			continue
+		}
+		params.Automatic = false
+		containers = append(containers, params.ContainerNames...)
+	}
+	return containers
+}
+
+func hasConfigChangeTrigger(dc *appsv1.DeploymentConfig) bool {
+	for _, t := range dc.Spec.Triggers {
+		if t.Type == appsv1.DeploymentTriggerOnConfigChange {
+			return true
+		}
+	}
+	return false
+}
+
+// imageChanges lista as trocas de imagem por container entre dois templates.
+func imageChanges(before, after *corev1.PodTemplateSpec) []string {
+	if before == nil || after == nil {
+		return nil
+	}
+	old := map[string]string{}
+	for _, ctr := range before.Spec.Containers {
+		old[ctr.Name] = ctr.Image
+	}
+	var changes []string
+	for _, ctr := range after.Spec.Containers {
+		if prev, ok := old[ctr.Name]; ok && prev != ctr.Image {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", ctr.Name, prev, ctr.Image))
+		}
+	}
+	return changes
+}
@@ -0,0 +1,172 @@
+package deploymentconfigs
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_deploymentconfigs",
+		Description: "List OpenShift DeploymentConfigs with replicas, latest version and triggers",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list DeploymentConfigs from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter DeploymentConfigs",
+				},
+			},
+		},
+	}, newListDeploymentConfigsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "get_deploymentconfig",
+		Description: "Get an OpenShift DeploymentConfig with strategy, containers, conditions and its revisions (ReplicationControllers)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DeploymentConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DeploymentConfig",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newGetDeploymentConfigHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "scale_deploymentconfig",
+		Description: "Scale an OpenShift DeploymentConfig",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DeploymentConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DeploymentConfig",
+				},
+				"replicas": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of replicas",
+				},
+			},
+			Required: []string{"name", "namespace", "replicas"},
+		},
+	}, newScaleDeploymentConfigHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "rollout_latest",
+		Description: "Start a new rollout of a DeploymentConfig with the latest state of its triggers (like oc rollout latest)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DeploymentConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DeploymentConfig",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRolloutLatestHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "rollout_cancel",
+		Description: "Cancel the in-progress rollout of a DeploymentConfig (like oc rollout cancel); the previous revision is scaled back up",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DeploymentConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DeploymentConfig",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRolloutCancelHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "rollout_retry",
+		Description: "Retry the latest failed rollout of a DeploymentConfig (like oc rollout retry)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DeploymentConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DeploymentConfig",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRolloutRetryHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "rollback_deploymentconfig",
+		Description: "Roll a DeploymentConfig back to the pod template of a previous revision (like oc rollback); automatic image change triggers are disabled",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DeploymentConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DeploymentConfig",
+				},
+				"toVersion": map[string]interface{}{
+					"type":        "integer",
+					"description": "Revision to roll back to (default: the previous one)",
+				},
+				"dryRun": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Only show what would change",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRollbackDeploymentConfigHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "deploymentconfig_status",
+		Description: "Show the trigger configuration (ImageChange/ConfigChange), the cause of the latest rollout and the status of the latest ReplicationController and deployer pod of a DeploymentConfig",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the DeploymentConfig",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the DeploymentConfig",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newDeploymentConfigStatusHandler(clients))
+}
@@ -0,0 +1,141 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newListDeploymentsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		deployments, err := c.Kubernetes.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list deployments: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatDeploymentsList(deployments)), nil
+	}
+}
+
+func newGetDeploymentHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		deploy, err := c.Kubernetes.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deployment %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(formatDeploymentDetails(deploy)), nil
+	}
+}
+
+func newScaleDeploymentHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		if _, ok := args["replicas"]; !ok {
+			return mcp.NewToolResultError("replicas is required"), nil
+		}
+		replicas := utils.GetIntArg(args, "replicas", 0)
+		if replicas < 0 {
+			return mcp.NewToolResultError("replicas must be >= 0"), nil
+		}
+
+		scale, err := c.Kubernetes.AppsV1().Deployments(ns).GetScale(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get scale of deployment %s/%s: %v", ns, name, err)), nil
+		}
+		previous := scale.Spec.Replicas
+
+		scale.Spec = autoscalingv1.ScaleSpec{Replicas: int32(replicas)}
+		if _, err := c.Kubernetes.AppsV1().Deployments(ns).UpdateScale(ctx, name, scale, metav1.UpdateOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to scale deployment %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("Deployment %s/%s scaled from %d to %d replicas", ns, name, previous, replicas)
+		if hpa := deploymentHPA(ctx, c, ns, name); hpa != nil {
+			msg += fmt.Sprintf("\nWarning: horizontalpodautoscaler %s manages this deployment (min %d, max %d); "+
+				"it will override the manual scale on its next sync. Use update_hpa to change the replica range.",
+				hpa.Name, hpaMinReplicas(hpa), hpa.Spec.MaxReplicas)
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+// deploymentHPA devolve o HPA que tem o Deployment como scaleTargetRef, se houver.
+// Erros de listagem são ignorados: o aviso é só informativo.
+func deploymentHPA(ctx context.Context, c *clients.Clients, ns, name string) *autoscalingv2.HorizontalPodAutoscaler {
+	list, err := c.Kubernetes.AutoscalingV2().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	for i := range list.Items {
+		ref := list.Items[i].Spec.ScaleTargetRef
+		if ref.Kind == "Deployment" && ref.Name == name {
+			return &list.Items[i]
+		}
+	}
+	return nil
+}
+
+func hpaMinReplicas(hpa *autoscalingv2.HorizontalPodAutoscaler) int32 {
+	if hpa.Spec.MinReplicas != nil {
+		return *hpa.Spec.MinReplicas
+	}
+	return 1
+}
+
+func newRestartDeploymentHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		// mesmo mecanismo do kubectl rollout restart
+		patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`,
+			time.Now().Format(time.RFC3339))
+		if _, err := c.Kubernetes.AppsV1().Deployments(ns).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to restart deployment %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Deployment %s/%s restarted", ns, name)), nil
+	}
+}
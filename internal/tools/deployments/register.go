@@ -82,4 +82,79 @@ func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
 			Required: []string{"name", "namespace"},
 		},
 	}, newRestartDeploymentHandler(clients))
+	srv.AddTool(&mcp.Tool{
+		Name:        "rollout_status",
+		Description: "Wait for a deployment rollout to finish, reporting progress (sent as MCP progress notifications when the client provides a progressToken)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the deployment",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the deployment",
+				},
+				"timeoutSeconds": map[string]interface{}{
+					"type":        "integer",
+					"description": "How long to wait for the rollout (default 300, max 1800, 0 to report the current status only)",
+					"minimum":     0,
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRolloutStatusHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "rollout_history",
+		Description: "List the revisions of a deployment (its ReplicaSets) with change-cause and image changes, or show one revision in detail",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the deployment",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the deployment",
+				},
+				"revision": map[string]interface{}{
+					"type":        "integer",
+					"description": "Show the pod template of this revision",
+					"minimum":     1,
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRolloutHistoryHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "rollout_undo",
+		Description: "Roll a deployment back to a previous revision",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the deployment",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the deployment",
+				},
+				"toRevision": map[string]interface{}{
+					"type":        "integer",
+					"description": "Revision to roll back to (default: the previous revision)",
+					"minimum":     0,
+				},
+				"dryRun": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Validate the rollback with a server-side dry run",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRolloutUndoHandler(clients))
 }
@@ -0,0 +1,412 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+
+	defaultRolloutTimeoutSeconds = 300
+	maxRolloutTimeoutSeconds     = 1800
+	rolloutPollInterval          = 2 * time.Second
+)
+
+// annotationsSkippedOnRollback não são copiadas do ReplicaSet para o Deployment no undo (igual ao kubectl).
+var annotationsSkippedOnRollback = map[string]bool{
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+	revisionAnnotation:                          true,
+	"deployment.kubernetes.io/revision-history": true,
+	"deployment.kubernetes.io/desired-replicas": true,
+	"deployment.kubernetes.io/max-replicas":     true,
+	"deprecated.deployment.rollback.to":         true,
+}
+
+func newRolloutStatusHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		timeout := utils.GetIntArg(args, "timeoutSeconds", defaultRolloutTimeoutSeconds)
+		if timeout < 0 || timeout > maxRolloutTimeoutSeconds {
+			return mcp.NewToolResultError(fmt.Sprintf("timeoutSeconds must be between 0 and %d", maxRolloutTimeoutSeconds)), nil
+		}
+
+		report := utils.ProgressReporter(ctx, req)
+		var (
+			progress []string
+			last     string
+			done     bool
+		)
+		check := func(ctx context.Context) (bool, error) {
+			deploy, err := c.Kubernetes.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			msg, finished, err := deploymentRolloutStatus(deploy)
+			if err != nil {
+				return false, err
+			}
+			if msg != last {
+				last = msg
+				progress = append(progress, fmt.Sprintf("[%s] %s", time.Now().Format("15:04:05"), msg))
+				report(msg)
+			}
+			done = finished
+			return finished, nil
+		}
+
+		var err error
+		if timeout == 0 {
+			_, err = check(ctx)
+		} else {
+			err = wait.PollUntilContextTimeout(ctx, rolloutPollInterval, time.Duration(timeout)*time.Second, true, check)
+		}
+
+		text := strings.Join(progress, "\n")
+		switch {
+		case done:
+			return mcp.NewToolResultText(text), nil
+		case err != nil && wait.Interrupted(err):
+			return mcp.NewToolResultError(fmt.Sprintf("%s\n\nRollout of deployment %s/%s not finished after %ds.", text, ns, name, timeout)), nil
+		case err != nil:
+			return mcp.NewToolResultError(fmt.Sprintf("%s\n\nRollout of deployment %s/%s failed: %v", text, ns, name, err)), nil
+		default:
+			return mcp.NewToolResultText(text + "\n\n(not waiting: timeoutSeconds=0)"), nil
+		}
+	}
+}
+
+// deploymentRolloutStatus segue a mesma lógica do kubectl rollout status.
+func deploymentRolloutStatus(deploy *appsv1.Deployment) (string, bool, error) {
+	if deploy.Generation > deploy.Status.ObservedGeneration {
+		return "Waiting for deployment spec update to be observed...", false, nil
+	}
+	for _, cond := range deploy.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			return "", false, fmt.Errorf("deployment %q exceeded its progress deadline: %s", deploy.Name, cond.Message)
+		}
+	}
+
+	desired := int32(1)
+	if deploy.Spec.Replicas != nil {
+		desired = *deploy.Spec.Replicas
+	}
+	st := deploy.Status
+	switch {
+	case deploy.Spec.Paused:
+		return fmt.Sprintf("Deployment %q is paused; resume it to continue the rollout (%d of %d updated replicas)", deploy.Name, st.UpdatedReplicas, desired), false, nil
+	case st.UpdatedReplicas < desired:
+		return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d out of %d new replicas have been updated...", deploy.Name, st.UpdatedReplicas, desired), false, nil
+	case st.Replicas > st.UpdatedReplicas:
+		return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d old replicas are pending termination...", deploy.Name, st.Replicas-st.UpdatedReplicas), false, nil
+	case st.AvailableReplicas < st.UpdatedReplicas:
+		return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d of %d updated replicas are available...", deploy.Name, st.AvailableReplicas, st.UpdatedReplicas), false, nil
+	default:
+		return fmt.Sprintf("Deployment %q successfully rolled out (%d/%d available)", deploy.Name, st.AvailableReplicas, desired), true, nil
+	}
+}
+
+func newRolloutHistoryHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		deploy, err := c.Kubernetes.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deployment %s/%s: %v", ns, name, err)), nil
+		}
+		revisions, err := deploymentRevisions(ctx, c, deploy)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list ReplicaSets of deployment %s/%s: %v", ns, name, err)), nil
+		}
+		if len(revisions) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("No revisions found for deployment %s/%s.", ns, name)), nil
+		}
+
+		if want := utils.GetIntArg(args, "revision", 0); want > 0 {
+			for _, rev := range revisions {
+				if rev.number == int64(want) {
+					return mcp.NewToolResultText(formatRevisionDetails(deploy, rev)), nil
+				}
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("revision %d not found for deployment %s/%s", want, ns, name)), nil
+		}
+
+		return mcp.NewToolResultText(formatRolloutHistory(deploy, revisions)), nil
+	}
+}
+
+func newRolloutUndoHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		toRevision := int64(utils.GetIntArg(args, "toRevision", 0))
+		dryRun := utils.GetBoolArg(args, "dryRun", false)
+
+		deploy, err := c.Kubernetes.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deployment %s/%s: %v", ns, name, err)), nil
+		}
+		if deploy.Spec.Paused {
+			return mcp.NewToolResultError(fmt.Sprintf("deployment %s/%s is paused; resume it before rolling back", ns, name)), nil
+		}
+
+		revisions, err := deploymentRevisions(ctx, c, deploy)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list ReplicaSets of deployment %s/%s: %v", ns, name, err)), nil
+		}
+		target, err := rollbackTarget(revisions, toRevision)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Cannot roll back deployment %s/%s: %v", ns, name, err)), nil
+		}
+
+		template := target.rs.Spec.Template.DeepCopy()
+		delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+		if apiequality.Semantic.DeepEqual(template, &deploy.Spec.Template) {
+			return mcp.NewToolResultText(fmt.Sprintf("Skipped rollback: deployment %s/%s already matches revision %d.", ns, name, target.number)), nil
+		}
+
+		annotations := map[string]string{}
+		for k, v := range deploy.Annotations {
+			annotations[k] = v
+		}
+		for k, v := range target.rs.Annotations {
+			if !annotationsSkippedOnRollback[k] {
+				annotations[k] = v
+			}
+		}
+
+		patch, err := json.Marshal([]map[string]any{
+			{"op": "test", "path": "/metadata/resourceVersion", "value": deploy.ResourceVersion},
+			{"op": "replace", "path": "/spec/template", "value": template},
+			{"op": "add", "path": "/metadata/annotations", "value": annotations},
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to build rollback patch: %v", err)), nil
+		}
+
+		opts := metav1.PatchOptions{}
+		if dryRun {
+			opts.DryRun = []string{metav1.DryRunAll}
+		}
+		if _, err := c.Kubernetes.AppsV1().Deployments(ns).Patch(ctx, name, types.JSONPatchType, patch, opts); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to roll back deployment %s/%s: %v", ns, name, err)), nil
+		}
+
+		var sb strings.Builder
+		verb := "Rolled back"
+		if dryRun {
+			verb = "Would roll back (server dry run)"
+		}
+		sb.WriteString(fmt.Sprintf("%s deployment %s/%s to revision %d.\n", verb, ns, name, target.number))
+		if changes := imageChanges(deploy.Spec.Template.Spec.Containers, template.Spec.Containers); len(changes) > 0 {
+			sb.WriteString("\nImage changes:\n")
+			for _, ch := range changes {
+				sb.WriteString("  " + ch + "\n")
+			}
+		}
+		if !dryRun {
+			sb.WriteString("\nUse rollout_status to follow the new rollout.\n")
+		}
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+}
+
+// revision é um ReplicaSet do Deployment com o número da revisão.
+type revision struct {
+	number int64
+	rs     *appsv1.ReplicaSet
+}
+
+// deploymentRevisions lista os ReplicaSets controlados pelo Deployment, em ordem crescente de revisão.
+func deploymentRevisions(ctx context.Context, c *clients.Clients, deploy *appsv1.Deployment) ([]revision, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.Kubernetes.AppsV1().ReplicaSets(deploy.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []revision
+	for i := range list.Items {
+		rs := &list.Items[i]
+		if owner := metav1.GetControllerOf(rs); owner == nil || owner.UID != deploy.UID {
+			continue
+		}
+		n, err := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		revisions = append(revisions, revision{number: n, rs: rs})
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].number < revisions[j].number })
+	return revisions, nil
+}
+
+// rollbackTarget escolhe a revisão pedida ou, com toRevision=0, a anterior à atual.
+func rollbackTarget(revisions []revision, toRevision int64) (revision, error) {
+	if toRevision > 0 {
+		for _, rev := range revisions {
+			if rev.number == toRevision {
+				return rev, nil
+			}
+		}
+		return revision{}, fmt.Errorf("revision %d not found", toRevision)
+	}
+	if len(revisions) < 2 {
+		return revision{}, fmt.Errorf("no previous revision to roll back to")
+	}
+	return revisions[len(revisions)-2], nil
+}
+
+func formatRolloutHistory(deploy *appsv1.Deployment, revisions []revision) string {
+	var sb strings.Builder
+	current := deploy.Annotations[revisionAnnotation]
+
+	sb.WriteString(fmt.Sprintf("Deployment: %s\nNamespace: %s\n\n", deploy.Name, deploy.Namespace))
+	for i, rev := range revisions {
+		marker := ""
+		if strconv.FormatInt(rev.number, 10) == current {
+			marker = " (current)"
+		}
+		sb.WriteString(fmt.Sprintf("Revision %d%s\n", rev.number, marker))
+		sb.WriteString(fmt.Sprintf("  ReplicaSet: %s (%d/%d ready)\n", rev.rs.Name, rev.rs.Status.ReadyReplicas, rev.rs.Status.Replicas))
+		sb.WriteString(fmt.Sprintf("  Created: %s\n", rev.rs.CreationTimestamp.Format(time.RFC3339)))
+		cause := rev.rs.Annotations[changeCauseAnnotation]
+		if cause == "" {
+			cause = "<none>"
+		}
+		sb.WriteString(fmt.Sprintf("  Change-Cause: %s\n", cause))
+
+		if i == 0 {
+			for _, ctr := range rev.rs.Spec.Template.Spec.Containers {
+				sb.WriteString(fmt.Sprintf("  Image: %s=%s\n", ctr.Name, ctr.Image))
+			}
+		} else if changes := imageChanges(revisions[i-1].rs.Spec.Template.Spec.Containers, rev.rs.Spec.Template.Spec.Containers); len(changes) > 0 {
+			for _, ch := range changes {
+				sb.WriteString("  Image: " + ch + "\n")
+			}
+		} else {
+			sb.WriteString("  Image: unchanged\n")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func formatRevisionDetails(deploy *appsv1.Deployment, rev revision) string {
+	var sb strings.Builder
+	tmpl := rev.rs.Spec.Template
+
+	sb.WriteString(fmt.Sprintf("Deployment: %s\nNamespace: %s\nRevision: %d\n", deploy.Name, deploy.Namespace, rev.number))
+	sb.WriteString(fmt.Sprintf("ReplicaSet: %s\n", rev.rs.Name))
+	if cause := rev.rs.Annotations[changeCauseAnnotation]; cause != "" {
+		sb.WriteString(fmt.Sprintf("Change-Cause: %s\n", cause))
+	}
+
+	sb.WriteString("\nContainers:\n")
+	for _, ctr := range tmpl.Spec.Containers {
+		sb.WriteString(fmt.Sprintf("  Name: %s\n", ctr.Name))
+		sb.WriteString(fmt.Sprintf("  Image: %s\n", ctr.Image))
+		for _, env := range ctr.Env {
+			if env.ValueFrom != nil {
+				sb.WriteString(fmt.Sprintf("  Env: %s (from reference)\n", env.Name))
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("  Env: %s=%s\n", env.Name, env.Value))
+		}
+		if len(ctr.Resources.Requests) > 0 || len(ctr.Resources.Limits) > 0 {
+			sb.WriteString(fmt.Sprintf("  Resources: requests=%s limits=%s\n", resourceListString(ctr.Resources.Requests), resourceListString(ctr.Resources.Limits)))
+		}
+	}
+	return sb.String()
+}
+
+// imageChanges compara as imagens por nome de container ("name: old -> new").
+func imageChanges(before, after []corev1.Container) []string {
+	old := map[string]string{}
+	for _, ctr := range before {
+		old[ctr.Name] = ctr.Image
+	}
+
+	var changes []string
+	seen := map[string]bool{}
+	for _, ctr := range after {
+		seen[ctr.Name] = true
+		prev, ok := old[ctr.Name]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("%s: (added) %s", ctr.Name, ctr.Image))
+		case prev != ctr.Image:
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", ctr.Name, prev, ctr.Image))
+		}
+	}
+	for _, ctr := range before {
+		if !seen[ctr.Name] {
+			changes = append(changes, fmt.Sprintf("%s: (removed) %s", ctr.Name, ctr.Image))
+		}
+	}
+	return changes
+}
+
+func resourceListString(rl corev1.ResourceList) string {
+	if len(rl) == 0 {
+		return "<none>"
+	}
+	keys := make([]string, 0, len(rl))
+	for k := range rl {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		q := rl[corev1.ResourceName(k)]
+		parts = append(parts, k+"="+q.String())
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,233 @@
+package hpa
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func formatHPAsList(list *autoscalingv2.HorizontalPodAutoscalerList) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total HorizontalPodAutoscalers: %d\n\n", len(list.Items)))
+
+	for _, hpa := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", hpa.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", hpa.Namespace))
+		sb.WriteString(fmt.Sprintf("Target: %s/%s\n", hpa.Spec.ScaleTargetRef.Kind, hpa.Spec.ScaleTargetRef.Name))
+		sb.WriteString(fmt.Sprintf("Min/Max: %d/%d\n", minReplicas(&hpa), hpa.Spec.MaxReplicas))
+		sb.WriteString(fmt.Sprintf("Replicas: %d current, %d desired\n", hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas))
+		sb.WriteString("Metrics:\n")
+		writeMetrics(&sb, &hpa)
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatHPADetails(hpa *autoscalingv2.HorizontalPodAutoscaler, events []corev1.Event) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("HorizontalPodAutoscaler: %s\n", hpa.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", hpa.Namespace))
+	ref := hpa.Spec.ScaleTargetRef
+	sb.WriteString(fmt.Sprintf("Target: %s/%s (%s)\n", ref.Kind, ref.Name, ref.APIVersion))
+	sb.WriteString(fmt.Sprintf("Min Replicas: %d\n", minReplicas(hpa)))
+	sb.WriteString(fmt.Sprintf("Max Replicas: %d\n", hpa.Spec.MaxReplicas))
+	sb.WriteString(fmt.Sprintf("Current Replicas: %d\n", hpa.Status.CurrentReplicas))
+	sb.WriteString(fmt.Sprintf("Desired Replicas: %d\n", hpa.Status.DesiredReplicas))
+	if hpa.Status.LastScaleTime != nil {
+		sb.WriteString(fmt.Sprintf("Last Scale Time: %s (%s ago)\n",
+			hpa.Status.LastScaleTime.Format(time.RFC3339), time.Since(hpa.Status.LastScaleTime.Time).Round(time.Second)))
+	}
+
+	sb.WriteString("\nMetrics (current / target):\n")
+	writeMetrics(&sb, hpa)
+
+	if b := hpa.Spec.Behavior; b != nil {
+		sb.WriteString("\nBehavior:\n")
+		writeScalingRules(&sb, "Scale Up", b.ScaleUp)
+		writeScalingRules(&sb, "Scale Down", b.ScaleDown)
+	}
+
+	if len(hpa.Status.Conditions) > 0 {
+		sb.WriteString("\nConditions:\n")
+		for _, cond := range hpa.Status.Conditions {
+			sb.WriteString(fmt.Sprintf("  %s: %s (Reason: %s)", cond.Type, cond.Status, cond.Reason))
+			if cond.Message != "" {
+				sb.WriteString(" - " + cond.Message)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\nRecent Events:\n")
+	if len(events) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for i := range events {
+		ev := &events[i]
+		sb.WriteString(fmt.Sprintf("  %s %s %s: %s", eventTime(ev).Format(time.RFC3339), ev.Type, ev.Reason, strings.TrimSpace(ev.Message)))
+		if ev.Count > 1 {
+			sb.WriteString(fmt.Sprintf(" (x%d)", ev.Count))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// writeMetrics escreve cada métrica do spec com o valor atual correspondente do status.
+func writeMetrics(sb *strings.Builder, hpa *autoscalingv2.HorizontalPodAutoscaler) {
+	if len(hpa.Spec.Metrics) == 0 {
+		sb.WriteString("  <none>\n")
+		return
+	}
+	for i, m := range hpa.Spec.Metrics {
+		current := "<unknown>"
+		if i < len(hpa.Status.CurrentMetrics) {
+			current = metricCurrent(hpa.Status.CurrentMetrics[i])
+		}
+		sb.WriteString(fmt.Sprintf("  %s: %s / %s\n", metricName(m), current, targetString(metricTarget(m))))
+	}
+}
+
+func writeScalingRules(sb *strings.Builder, title string, rules *autoscalingv2.HPAScalingRules) {
+	if rules == nil {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("  %s:", title))
+	if rules.StabilizationWindowSeconds != nil {
+		sb.WriteString(fmt.Sprintf(" stabilization %ds", *rules.StabilizationWindowSeconds))
+	}
+	if rules.SelectPolicy != nil {
+		sb.WriteString(fmt.Sprintf(", select %s", *rules.SelectPolicy))
+	}
+	sb.WriteString("\n")
+	for _, p := range rules.Policies {
+		sb.WriteString(fmt.Sprintf("    %s %d per %ds\n", p.Type, p.Value, p.PeriodSeconds))
+	}
+}
+
+func metricName(m autoscalingv2.MetricSpec) string {
+	switch m.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if m.Resource != nil {
+			return "resource " + string(m.Resource.Name)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if m.ContainerResource != nil {
+			return fmt.Sprintf("resource %s of container %s", m.ContainerResource.Name, m.ContainerResource.Container)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if m.Pods != nil {
+			return "pods metric " + m.Pods.Metric.Name
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if m.Object != nil {
+			return fmt.Sprintf("object metric %s on %s/%s", m.Object.Metric.Name, m.Object.DescribedObject.Kind, m.Object.DescribedObject.Name)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if m.External != nil {
+			return "external metric " + m.External.Metric.Name
+		}
+	}
+	return string(m.Type)
+}
+
+func metricTarget(m autoscalingv2.MetricSpec) autoscalingv2.MetricTarget {
+	switch {
+	case m.Resource != nil:
+		return m.Resource.Target
+	case m.ContainerResource != nil:
+		return m.ContainerResource.Target
+	case m.Pods != nil:
+		return m.Pods.Target
+	case m.Object != nil:
+		return m.Object.Target
+	case m.External != nil:
+		return m.External.Target
+	}
+	return autoscalingv2.MetricTarget{}
+}
+
+func targetString(t autoscalingv2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *t.AverageUtilization)
+	case t.AverageValue != nil:
+		return t.AverageValue.String() + " (avg)"
+	case t.Value != nil:
+		return t.Value.String()
+	}
+	return "<unset>"
+}
+
+func metricCurrent(s autoscalingv2.MetricStatus) string {
+	var v autoscalingv2.MetricValueStatus
+	switch {
+	case s.Resource != nil:
+		v = s.Resource.Current
+	case s.ContainerResource != nil:
+		v = s.ContainerResource.Current
+	case s.Pods != nil:
+		v = s.Pods.Current
+	case s.Object != nil:
+		v = s.Object.Current
+	case s.External != nil:
+		v = s.External.Current
+	default:
+		return "<unknown>"
+	}
+	switch {
+	case v.AverageUtilization != nil:
+		current := fmt.Sprintf("%d%%", *v.AverageUtilization)
+		if v.AverageValue != nil {
+			current += fmt.Sprintf(" (%s)", v.AverageValue.String())
+		}
+		return current
+	case v.AverageValue != nil:
+		return v.AverageValue.String() + " (avg)"
+	case v.Value != nil:
+		return v.Value.String()
+	}
+	return "<unknown>"
+}
+
+// metricTargets resume os alvos em uma linha, ex.: "cpu 70%, memory 80%".
+func metricTargets(metrics []autoscalingv2.MetricSpec) string {
+	parts := make([]string, 0, len(metrics))
+	for _, m := range metrics {
+		name := metricName(m)
+		if m.Resource != nil {
+			name = string(m.Resource.Name)
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", name, targetString(metricTarget(m))))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// hpaSummary é a linha usada por update_hpa para comparar antes e depois.
+func hpaSummary(hpa *autoscalingv2.HorizontalPodAutoscaler) string {
+	return fmt.Sprintf("min %d, max %d, %s", minReplicas(hpa), hpa.Spec.MaxReplicas, metricTargets(hpa.Spec.Metrics))
+}
+
+func minReplicas(hpa *autoscalingv2.HorizontalPodAutoscaler) int32 {
+	if hpa.Spec.MinReplicas != nil {
+		return *hpa.Spec.MinReplicas
+	}
+	return 1
+}
+
+func eventTime(ev *corev1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	}
+	return ev.CreationTimestamp.Time
+}
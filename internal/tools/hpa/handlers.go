@@ -0,0 +1,282 @@
+package hpa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// recentEvents é quantos eventos get_hpa mostra (os mais recentes).
+const recentEvents = 10
+
+func newListHPAsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		list, err := c.Kubernetes.AutoscalingV2().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list horizontalpodautoscalers: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatHPAsList(list)), nil
+	}
+}
+
+func newGetHPAHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		hpa, err := c.Kubernetes.AutoscalingV2().HorizontalPodAutoscalers(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get horizontalpodautoscaler %s/%s: %v", ns, name, err)), nil
+		}
+
+		// Eventos são complementares: uma falha aqui não impede o resto da saída
+		events, _ := hpaEvents(ctx, c, hpa)
+
+		return mcp.NewToolResultText(formatHPADetails(hpa, events)), nil
+	}
+}
+
+func newCreateHPAHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		ns := utils.GetStringArg(args, "namespace", "")
+		target := utils.GetStringArg(args, "targetName", "")
+		if ns == "" || target == "" {
+			return mcp.NewToolResultError("namespace and targetName are required"), nil
+		}
+		kind := utils.GetStringArg(args, "targetKind", "Deployment")
+		if _, ok := scalableKinds[kind]; !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("unsupported targetKind %q (use Deployment, StatefulSet or ReplicaSet)", kind)), nil
+		}
+		name := utils.GetStringArg(args, "name", target)
+
+		minReplicas := int32(utils.GetIntArg(args, "minReplicas", 1))
+		maxReplicas := int32(utils.GetIntArg(args, "maxReplicas", 0))
+		cpu := utils.GetIntArg(args, "cpuUtilization", 0)
+		memory := utils.GetIntArg(args, "memoryUtilization", 0)
+		if cpu == 0 && memory == 0 {
+			return mcp.NewToolResultError("at least one of cpuUtilization or memoryUtilization is required"), nil
+		}
+
+		existing, err := hpaForTarget(ctx, c, ns, kind, target)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list horizontalpodautoscalers in %s: %v", ns, err)), nil
+		}
+		if existing != "" {
+			return mcp.NewToolResultError(fmt.Sprintf("%s %s/%s is already targeted by horizontalpodautoscaler %s; use update_hpa", kind, ns, target, existing)), nil
+		}
+
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+					APIVersion: scalableKinds[kind],
+					Kind:       kind,
+					Name:       target,
+				},
+				MinReplicas: &minReplicas,
+				MaxReplicas: maxReplicas,
+			},
+		}
+		hpa.Spec.Metrics = setUtilization(hpa.Spec.Metrics, corev1.ResourceCPU, cpu)
+		hpa.Spec.Metrics = setUtilization(hpa.Spec.Metrics, corev1.ResourceMemory, memory)
+		if err := validateHPA(hpa); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		opts := metav1.CreateOptions{}
+		if utils.GetBoolArg(args, "dryRun", false) {
+			opts.DryRun = []string{metav1.DryRunAll}
+		}
+		created, err := c.Kubernetes.AutoscalingV2().HorizontalPodAutoscalers(ns).Create(ctx, hpa, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create horizontalpodautoscaler %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("HorizontalPodAutoscaler %s/%s created for %s %s (%d-%d replicas, %s)",
+			ns, created.Name, kind, target, minReplicas, maxReplicas, metricTargets(created.Spec.Metrics))
+		if len(opts.DryRun) > 0 {
+			msg += " (dry run)"
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+func newUpdateHPAHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		hpa, err := c.Kubernetes.AutoscalingV2().HorizontalPodAutoscalers(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get horizontalpodautoscaler %s/%s: %v", ns, name, err)), nil
+		}
+		before := hpaSummary(hpa)
+
+		if _, ok := args["minReplicas"]; ok {
+			v := int32(utils.GetIntArg(args, "minReplicas", 1))
+			hpa.Spec.MinReplicas = &v
+		}
+		if _, ok := args["maxReplicas"]; ok {
+			hpa.Spec.MaxReplicas = int32(utils.GetIntArg(args, "maxReplicas", 0))
+		}
+		if _, ok := args["cpuUtilization"]; ok {
+			hpa.Spec.Metrics = setUtilization(hpa.Spec.Metrics, corev1.ResourceCPU, utils.GetIntArg(args, "cpuUtilization", 0))
+		}
+		if _, ok := args["memoryUtilization"]; ok {
+			hpa.Spec.Metrics = setUtilization(hpa.Spec.Metrics, corev1.ResourceMemory, utils.GetIntArg(args, "memoryUtilization", 0))
+		}
+		if err := validateHPA(hpa); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		after := hpaSummary(hpa)
+		if after == before {
+			return mcp.NewToolResultText(fmt.Sprintf("HorizontalPodAutoscaler %s/%s unchanged: %s", ns, name, after)), nil
+		}
+
+		opts := metav1.UpdateOptions{}
+		if utils.GetBoolArg(args, "dryRun", false) {
+			opts.DryRun = []string{metav1.DryRunAll}
+		}
+		if _, err := c.Kubernetes.AutoscalingV2().HorizontalPodAutoscalers(ns).Update(ctx, hpa, opts); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update horizontalpodautoscaler %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("HorizontalPodAutoscaler %s/%s updated\n  before: %s\n  after:  %s", ns, name, before, after)
+		if len(opts.DryRun) > 0 {
+			msg += "\n(dry run)"
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+// scalableKinds são os alvos aceitos por create_hpa, com a apiVersion usada no scaleTargetRef.
+var scalableKinds = map[string]string{
+	"Deployment":  "apps/v1",
+	"StatefulSet": "apps/v1",
+	"ReplicaSet":  "apps/v1",
+}
+
+// setUtilization define (ou remove, com target 0) a métrica de utilização média de um resource.
+// Métricas de outros tipos são preservadas.
+func setUtilization(metrics []autoscalingv2.MetricSpec, resource corev1.ResourceName, target int) []autoscalingv2.MetricSpec {
+	out := metrics[:0:0]
+	found := false
+	for _, m := range metrics {
+		if m.Type == autoscalingv2.ResourceMetricSourceType && m.Resource != nil && m.Resource.Name == resource {
+			found = true
+			if target <= 0 {
+				continue
+			}
+			utilization := int32(target)
+			m.Resource.Target = autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			}
+		}
+		out = append(out, m)
+	}
+	if !found && target > 0 {
+		utilization := int32(target)
+		out = append(out, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: resource,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: &utilization,
+				},
+			},
+		})
+	}
+	return out
+}
+
+func validateHPA(hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+	minReplicas := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	switch {
+	case minReplicas < 1:
+		return fmt.Errorf("minReplicas must be >= 1")
+	case hpa.Spec.MaxReplicas < 1:
+		return fmt.Errorf("maxReplicas is required and must be >= 1")
+	case hpa.Spec.MaxReplicas < minReplicas:
+		return fmt.Errorf("maxReplicas (%d) must be >= minReplicas (%d)", hpa.Spec.MaxReplicas, minReplicas)
+	case len(hpa.Spec.Metrics) == 0:
+		return fmt.Errorf("the horizontalpodautoscaler must keep at least one metric")
+	}
+	return nil
+}
+
+// hpaForTarget devolve o nome do HPA do namespace que aponta para kind/name, ou "".
+func hpaForTarget(ctx context.Context, c *clients.Clients, ns, kind, name string) (string, error) {
+	list, err := c.Kubernetes.AutoscalingV2().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, hpa := range list.Items {
+		ref := hpa.Spec.ScaleTargetRef
+		if ref.Kind == kind && ref.Name == name {
+			return hpa.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// hpaEvents devolve os eventos mais recentes do HPA (SuccessfulRescale, FailedGetResourceMetric...).
+func hpaEvents(ctx context.Context, c *clients.Clients, hpa *autoscalingv2.HorizontalPodAutoscaler) ([]corev1.Event, error) {
+	selector := fields.Set{
+		"involvedObject.kind": "HorizontalPodAutoscaler",
+		"involvedObject.name": hpa.Name,
+	}.AsSelector().String()
+	list, err := c.Kubernetes.CoreV1().Events(hpa.Namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	events := list.Items
+	sort.Slice(events, func(i, j int) bool { return eventTime(&events[i]).Before(eventTime(&events[j])) })
+	if len(events) > recentEvents {
+		events = events[len(events)-recentEvents:]
+	}
+	return events, nil
+}
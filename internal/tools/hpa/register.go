@@ -0,0 +1,132 @@
+package hpa
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_hpas",
+		Description: "List HorizontalPodAutoscalers with target, min/max, current/desired replicas and current vs target metrics",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list HPAs from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter HPAs",
+				},
+			},
+		},
+	}, newListHPAsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "get_hpa",
+		Description: "Get a HorizontalPodAutoscaler with current vs target metrics, scaling behavior, conditions (AbleToScale, ScalingActive, ScalingLimited) and recent scaling events",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the HPA",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the HPA",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newGetHPAHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "create_hpa",
+		Description: "Create a HorizontalPodAutoscaler (autoscaling/v2) with CPU and/or memory average utilization targets",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the HPA and its target",
+				},
+				"targetName": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the workload to scale",
+				},
+				"targetKind": map[string]interface{}{
+					"type":        "string",
+					"description": "Kind of the workload: Deployment (default), StatefulSet or ReplicaSet",
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the HPA (default: targetName)",
+				},
+				"minReplicas": map[string]interface{}{
+					"type":        "integer",
+					"description": "Minimum replicas (default 1)",
+				},
+				"maxReplicas": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum replicas",
+				},
+				"cpuUtilization": map[string]interface{}{
+					"type":        "integer",
+					"description": "Target average CPU utilization in percent of requests",
+				},
+				"memoryUtilization": map[string]interface{}{
+					"type":        "integer",
+					"description": "Target average memory utilization in percent of requests",
+				},
+				"dryRun": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Validate on the server without persisting",
+				},
+			},
+			Required: []string{"namespace", "targetName", "maxReplicas"},
+		},
+	}, newCreateHPAHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "update_hpa",
+		Description: "Update min/max replicas and CPU/memory utilization targets of a HorizontalPodAutoscaler; a utilization of 0 removes that metric, other metrics are kept",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the HPA",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the HPA",
+				},
+				"minReplicas": map[string]interface{}{
+					"type":        "integer",
+					"description": "New minimum replicas",
+				},
+				"maxReplicas": map[string]interface{}{
+					"type":        "integer",
+					"description": "New maximum replicas",
+				},
+				"cpuUtilization": map[string]interface{}{
+					"type":        "integer",
+					"description": "New target average CPU utilization in percent (0 removes the CPU metric)",
+				},
+				"memoryUtilization": map[string]interface{}{
+					"type":        "integer",
+					"description": "New target average memory utilization in percent (0 removes the memory metric)",
+				},
+				"dryRun": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Validate on the server without persisting",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newUpdateHPAHandler(clients))
+}
@@ -0,0 +1,228 @@
+package jobs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// failedPodLogs guarda as últimas linhas de log do pod com falha mais recente de um Job.
+type failedPodLogs struct {
+	pod       string
+	container string
+	previous  bool // logs da execução anterior do container
+	text      string
+	err       error
+}
+
+func formatJobsList(list *batchv1.JobList) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total Jobs: %d\n\n", len(list.Items)))
+
+	for _, job := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", job.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", job.Namespace))
+		sb.WriteString(fmt.Sprintf("Status: %s\n", jobStatus(&job)))
+		sb.WriteString(fmt.Sprintf("Completions: %d/%s\n", job.Status.Succeeded, completions(&job)))
+		if job.Status.Failed > 0 {
+			sb.WriteString(fmt.Sprintf("Failed: %d\n", job.Status.Failed))
+		}
+		if d := jobDuration(&job); d != "" {
+			sb.WriteString(fmt.Sprintf("Duration: %s\n", d))
+		}
+		if owner := ownerCronJob(&job); owner != "" {
+			sb.WriteString(fmt.Sprintf("CronJob: %s\n", owner))
+		}
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatJobDetails(job *batchv1.Job, pods []corev1.Pod, logs *failedPodLogs) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Job: %s\n", job.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", job.Namespace))
+	if owner := ownerCronJob(job); owner != "" {
+		sb.WriteString(fmt.Sprintf("CronJob: %s\n", owner))
+	}
+	sb.WriteString(fmt.Sprintf("Status: %s\n", jobStatus(job)))
+	sb.WriteString(fmt.Sprintf("Completions: %d/%s\n", job.Status.Succeeded, completions(job)))
+	parallelism := int32(1)
+	if job.Spec.Parallelism != nil {
+		parallelism = *job.Spec.Parallelism
+	}
+	sb.WriteString(fmt.Sprintf("Parallelism: %d\n", parallelism))
+	sb.WriteString(fmt.Sprintf("Active: %d, Succeeded: %d, Failed: %d\n", job.Status.Active, job.Status.Succeeded, job.Status.Failed))
+	backoffLimit := int32(6)
+	if job.Spec.BackoffLimit != nil {
+		backoffLimit = *job.Spec.BackoffLimit
+	}
+	sb.WriteString(fmt.Sprintf("Backoff Limit: %d\n", backoffLimit))
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		sb.WriteString(fmt.Sprintf("Active Deadline: %ds\n", *job.Spec.ActiveDeadlineSeconds))
+	}
+	if job.Status.StartTime != nil {
+		sb.WriteString(fmt.Sprintf("Start Time: %s\n", job.Status.StartTime.Format(time.RFC3339)))
+	}
+	if job.Status.CompletionTime != nil {
+		sb.WriteString(fmt.Sprintf("Completion Time: %s\n", job.Status.CompletionTime.Format(time.RFC3339)))
+	}
+	if d := jobDuration(job); d != "" {
+		sb.WriteString(fmt.Sprintf("Duration: %s\n", d))
+	}
+
+	sb.WriteString("\nContainers:\n")
+	for _, container := range job.Spec.Template.Spec.Containers {
+		sb.WriteString(fmt.Sprintf("  Name: %s\n", container.Name))
+		sb.WriteString(fmt.Sprintf("  Image: %s\n", container.Image))
+	}
+
+	if len(job.Status.Conditions) > 0 {
+		sb.WriteString("\nConditions:\n")
+		for _, cond := range job.Status.Conditions {
+			sb.WriteString(fmt.Sprintf("  %s: %s (Reason: %s)", cond.Type, cond.Status, cond.Reason))
+			if cond.Message != "" {
+				sb.WriteString(" - " + cond.Message)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\nPods:\n")
+	if len(pods) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, pod := range pods {
+		sb.WriteString(fmt.Sprintf("  %s: %s", pod.Name, pod.Status.Phase))
+		for _, st := range pod.Status.ContainerStatuses {
+			switch {
+			case st.State.Terminated != nil:
+				sb.WriteString(fmt.Sprintf(", %s exited %d (%s)", st.Name, st.State.Terminated.ExitCode, st.State.Terminated.Reason))
+			case st.State.Waiting != nil && st.State.Waiting.Reason != "":
+				sb.WriteString(fmt.Sprintf(", %s %s", st.Name, st.State.Waiting.Reason))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	if logs != nil {
+		run := ""
+		if logs.previous {
+			run = ", previous run"
+		}
+		sb.WriteString(fmt.Sprintf("\nLogs of last failed pod %s (container %s%s, last %d lines):\n", logs.pod, logs.container, run, failedPodLogLines))
+		if logs.err != nil {
+			sb.WriteString(fmt.Sprintf("  <unavailable: %v>\n", logs.err))
+		} else {
+			sb.WriteString(logs.text)
+			if !strings.HasSuffix(logs.text, "\n") {
+				sb.WriteString("\n")
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+func formatCronJobsList(list *batchv1.CronJobList, now time.Time) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total CronJobs: %d\n\n", len(list.Items)))
+
+	for _, cj := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", cj.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", cj.Namespace))
+		schedule := cj.Spec.Schedule
+		if cj.Spec.TimeZone != nil {
+			schedule += fmt.Sprintf(" (%s)", *cj.Spec.TimeZone)
+		}
+		sb.WriteString(fmt.Sprintf("Schedule: %s\n", schedule))
+		suspended := cj.Spec.Suspend != nil && *cj.Spec.Suspend
+		sb.WriteString(fmt.Sprintf("Suspended: %t\n", suspended))
+		sb.WriteString(fmt.Sprintf("Active: %d\n", len(cj.Status.Active)))
+
+		last := "<never>"
+		if cj.Status.LastScheduleTime != nil {
+			last = fmt.Sprintf("%s (%s ago)", cj.Status.LastScheduleTime.Format(time.RFC3339), now.Sub(cj.Status.LastScheduleTime.Time).Round(time.Second))
+		}
+		sb.WriteString(fmt.Sprintf("Last Schedule: %s\n", last))
+		if cj.Status.LastSuccessfulTime != nil {
+			sb.WriteString(fmt.Sprintf("Last Successful: %s\n", cj.Status.LastSuccessfulTime.Format(time.RFC3339)))
+		}
+
+		if suspended {
+			sb.WriteString("Next Schedule: <suspended>\n")
+		} else if next := nextSchedule(&cj, now); next != "" {
+			sb.WriteString(fmt.Sprintf("Next Schedule: %s\n", next))
+		}
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+// nextSchedule calcula o próximo disparo com o mesmo parser do controller de CronJob
+// (robfig/cron, ParseStandard), incluindo spec.timeZone e prefixos TZ=/CRON_TZ=.
+func nextSchedule(cj *batchv1.CronJob, now time.Time) string {
+	sched, err := cron.ParseStandard(cronSchedule(cj))
+	if err != nil {
+		return fmt.Sprintf("<invalid schedule: %v>", err)
+	}
+	// Sem fuso explícito o controller avalia no horário local do kube-controller-manager (UTC)
+	next := sched.Next(now.UTC())
+	if next.IsZero() {
+		return "<none in the next 5 years>"
+	}
+	return fmt.Sprintf("%s (in %s)", next.Format(time.RFC3339), next.Sub(now).Round(time.Second))
+}
+
+// cronSchedule monta a expressão como o controller: spec.timeZone vira prefixo TZ=,
+// a menos que o schedule já traga o fuso.
+func cronSchedule(cj *batchv1.CronJob) string {
+	if cj.Spec.TimeZone != nil && !strings.Contains(cj.Spec.Schedule, "TZ") {
+		return fmt.Sprintf("TZ=%s %s", *cj.Spec.TimeZone, cj.Spec.Schedule)
+	}
+	return cj.Spec.Schedule
+}
+
+// jobStatus resume as conditions do Job como kubectl (Complete, Failed, Suspended ou Running).
+func jobStatus(job *batchv1.Job) string {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete, batchv1.JobFailed, batchv1.JobSuspended:
+			return string(cond.Type)
+		}
+	}
+	if job.Status.Active > 0 {
+		return "Running"
+	}
+	return "Pending"
+}
+
+func completions(job *batchv1.Job) string {
+	if job.Spec.Completions != nil {
+		return fmt.Sprintf("%d", *job.Spec.Completions)
+	}
+	return "1"
+}
+
+func jobDuration(job *batchv1.Job) string {
+	if job.Status.StartTime == nil {
+		return ""
+	}
+	end := time.Now()
+	if job.Status.CompletionTime != nil {
+		end = job.Status.CompletionTime.Time
+	}
+	return end.Sub(job.Status.StartTime.Time).Round(time.Second).String()
+}
@@ -0,0 +1,323 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// failedPodLogLines é quantas linhas de log do último pod com falha get_job mostra;
+// failedPodLogBytes limita o tamanho dessas linhas (o kubelet corta a resposta).
+const (
+	failedPodLogLines = 50
+	failedPodLogBytes = 64 * 1024
+)
+
+func newListJobsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+		cronJob := utils.GetStringArg(args, "cronjob", "")
+
+		list, err := c.Kubernetes.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list jobs: %v", err)), nil
+		}
+
+		items := list.Items[:0]
+		for _, job := range list.Items {
+			if cronJob != "" && ownerCronJob(&job) != cronJob {
+				continue
+			}
+			items = append(items, job)
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].Namespace != items[j].Namespace {
+				return items[i].Namespace < items[j].Namespace
+			}
+			return items[i].CreationTimestamp.After(items[j].CreationTimestamp.Time)
+		})
+		list.Items = items
+
+		return mcp.NewToolResultText(formatJobsList(list)), nil
+	}
+}
+
+func newGetJobHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		job, err := c.Kubernetes.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get job %s/%s: %v", ns, name, err)), nil
+		}
+
+		pods, err := jobPods(ctx, c, job)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list pods of job %s/%s: %v", ns, name, err)), nil
+		}
+
+		var logs *failedPodLogs
+		if pod, container, previous := lastFailedPod(pods); pod != nil {
+			logs = &failedPodLogs{pod: pod.Name, container: container, previous: previous}
+			logs.text, logs.err = podLogTail(ctx, c, pod, container, previous)
+		}
+
+		return mcp.NewToolResultText(formatJobDetails(job, pods, logs)), nil
+	}
+}
+
+func newDeleteJobHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		// Sem propagation explícita a API de Jobs deixa os pods órfãos
+		propagation := metav1.DeletePropagationBackground
+		if !utils.GetBoolArg(args, "cascade", true) {
+			propagation = metav1.DeletePropagationOrphan
+		}
+		opts := metav1.DeleteOptions{PropagationPolicy: &propagation}
+		if utils.GetBoolArg(args, "dryRun", false) {
+			opts.DryRun = []string{metav1.DryRunAll}
+		}
+
+		if err := c.Kubernetes.BatchV1().Jobs(ns).Delete(ctx, name, opts); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete job %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("Job %s/%s deleted", ns, name)
+		if propagation == metav1.DeletePropagationOrphan {
+			msg += " (pods orphaned)"
+		} else {
+			msg += " with its pods"
+		}
+		if len(opts.DryRun) > 0 {
+			msg += " (dry run)"
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+func newListCronJobsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		list, err := c.Kubernetes.BatchV1().CronJobs(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list cronjobs: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatCronJobsList(list, time.Now())), nil
+	}
+}
+
+func newTriggerCronJobHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		cj, err := c.Kubernetes.BatchV1().CronJobs(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get cronjob %s/%s: %v", ns, name, err)), nil
+		}
+
+		jobName := utils.GetStringArg(args, "jobName", "")
+		if jobName == "" {
+			jobName = manualJobName(cj.Name)
+		}
+		job := jobFromCronJob(cj, jobName)
+
+		created, err := c.Kubernetes.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create job from cronjob %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("Job %s/%s created from cronjob %s", ns, created.Name, cj.Name)
+		if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+			msg += "\nThe cronjob is suspended; only this manual run was started."
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+// newSuspendCronJobHandler serve suspend_cronjob (suspend=true) e resume_cronjob (suspend=false).
+func newSuspendCronJobHandler(c *clients.Clients, suspend bool) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		action, done := "suspend", "suspended"
+		if !suspend {
+			action, done = "resume", "resumed"
+		}
+
+		patch := fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)
+		cj, err := c.Kubernetes.BatchV1().CronJobs(ns).Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to %s cronjob %s/%s: %v", action, ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("CronJob %s/%s %s", ns, name, done)
+		if suspend {
+			if n := len(cj.Status.Active); n > 0 {
+				msg += fmt.Sprintf("\n%d job(s) already running are not affected.", n)
+			}
+		} else if next := nextSchedule(cj, time.Now()); next != "" {
+			msg += fmt.Sprintf("\nNext schedule: %s", next)
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+// manualJobName segue o padrão de kubectl create job --from: <cronjob>-manual-<sufixo>,
+// respeitando o limite de 63 caracteres do label job-name.
+func manualJobName(cronJob string) string {
+	suffix := "-manual-" + rand.String(5)
+	if max := 63 - len(suffix); len(cronJob) > max {
+		cronJob = strings.TrimRight(cronJob[:max], "-.")
+	}
+	return cronJob + suffix
+}
+
+// jobFromCronJob monta o Job a partir do jobTemplate, como kubectl create job --from=cronjob/...
+func jobFromCronJob(cj *batchv1.CronJob, name string) *batchv1.Job {
+	annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+	for k, v := range cj.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+	isController := true
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   cj.Namespace,
+			Labels:      cj.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: batchv1.SchemeGroupVersion.String(),
+				Kind:       "CronJob",
+				Name:       cj.Name,
+				UID:        cj.UID,
+				Controller: &isController,
+			}},
+		},
+		Spec: *cj.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+}
+
+// jobPods lista os pods controlados pelo Job, ordenados por criação.
+func jobPods(ctx context.Context, c *clients.Clients, job *batchv1.Job) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.Kubernetes.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []corev1.Pod
+	for _, pod := range list.Items {
+		if owner := metav1.GetControllerOf(&pod); owner != nil && owner.UID == job.UID {
+			pods = append(pods, pod)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+	return pods, nil
+}
+
+// lastFailedPod devolve o pod com falha mais recente e o container que falhou; previous
+// indica que a falha é da execução anterior do container (LastTerminationState).
+func lastFailedPod(pods []corev1.Pod) (*corev1.Pod, string, bool) {
+	for i := len(pods) - 1; i >= 0; i-- {
+		pod := &pods[i]
+		for _, st := range pod.Status.ContainerStatuses {
+			if t := st.State.Terminated; t != nil && t.ExitCode != 0 {
+				return pod, st.Name, false
+			}
+			if t := st.LastTerminationState.Terminated; t != nil && t.ExitCode != 0 {
+				return pod, st.Name, true
+			}
+		}
+		if pod.Status.Phase == corev1.PodFailed && len(pod.Spec.Containers) > 0 {
+			return pod, pod.Spec.Containers[0].Name, false
+		}
+	}
+	return nil, "", false
+}
+
+func podLogTail(ctx context.Context, c *clients.Clients, pod *corev1.Pod, container string, previous bool) (string, error) {
+	tail := int64(failedPodLogLines)
+	limit := int64(failedPodLogBytes)
+	raw, err := c.Kubernetes.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container:  container,
+		TailLines:  &tail,
+		LimitBytes: &limit,
+		Previous:   previous,
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// ownerCronJob devolve o nome do CronJob que controla o Job, ou "".
+func ownerCronJob(job *batchv1.Job) string {
+	if owner := metav1.GetControllerOf(job); owner != nil && owner.Kind == "CronJob" {
+		return owner.Name
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+package jobs
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_jobs",
+		Description: "List Jobs with status, completions, failures and owning CronJob",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list Jobs from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter Jobs",
+				},
+				"cronjob": map[string]interface{}{
+					"type":        "string",
+					"description": "Only Jobs created by this CronJob",
+				},
+			},
+		},
+	}, newListJobsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "get_job",
+		Description: "Get a Job with completions, failures, conditions, its pods and the last log lines of the most recent failed pod",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the Job",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the Job",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newGetJobHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "delete_job",
+		Description: "Delete a Job; its pods are deleted too unless cascade is false",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the Job",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the Job",
+				},
+				"cascade": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Delete the Job's pods as well (default true); false leaves them orphaned",
+				},
+				"dryRun": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Validate the deletion on the server without persisting it",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newDeleteJobHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_cronjobs",
+		Description: "List CronJobs with schedule, suspension, active jobs, last schedule and the next schedule computed from the cron expression",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list CronJobs from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter CronJobs",
+				},
+			},
+		},
+	}, newListCronJobsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "trigger_cronjob",
+		Description: "Run a CronJob now by creating a Job from its job template (like kubectl create job --from=cronjob/<name>)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the CronJob",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the CronJob",
+				},
+				"jobName": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the Job to create (default <cronjob>-manual-<random>)",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newTriggerCronJobHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "suspend_cronjob",
+		Description: "Suspend a CronJob so no new Jobs are scheduled; running Jobs are not affected",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the CronJob",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the CronJob",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newSuspendCronJobHandler(clients, true))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "resume_cronjob",
+		Description: "Resume a suspended CronJob",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the CronJob",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the CronJob",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newSuspendCronJobHandler(clients, false))
+}
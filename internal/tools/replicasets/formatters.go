@@ -0,0 +1,90 @@
+package replicasets
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const revisionAnnotation = "deployment.kubernetes.io/revision"
+
+func formatReplicaSetsList(list *appsv1.ReplicaSetList) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total ReplicaSets: %d\n\n", len(list.Items)))
+
+	for _, rs := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", rs.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", rs.Namespace))
+		sb.WriteString(fmt.Sprintf("Replicas: %d/%d (available %d)\n", rs.Status.ReadyReplicas, desiredReplicas(&rs), rs.Status.AvailableReplicas))
+		sb.WriteString(fmt.Sprintf("Owner: %s\n", ownerString(&rs)))
+		if rev := rs.Annotations[revisionAnnotation]; rev != "" {
+			sb.WriteString(fmt.Sprintf("Revision: %s\n", rev))
+		}
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatReplicaSetDetails(rs *appsv1.ReplicaSet, pods []corev1.Pod) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("ReplicaSet: %s\n", rs.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", rs.Namespace))
+	sb.WriteString(fmt.Sprintf("Owner: %s\n", ownerString(rs)))
+	if rev := rs.Annotations[revisionAnnotation]; rev != "" {
+		sb.WriteString(fmt.Sprintf("Revision: %s\n", rev))
+	}
+	if cause := rs.Annotations["kubernetes.io/change-cause"]; cause != "" {
+		sb.WriteString(fmt.Sprintf("Change-Cause: %s\n", cause))
+	}
+	sb.WriteString(fmt.Sprintf("Replicas: %d desired, %d current, %d ready, %d available\n",
+		desiredReplicas(rs), rs.Status.Replicas, rs.Status.ReadyReplicas, rs.Status.AvailableReplicas))
+
+	if rs.Spec.Selector != nil && len(rs.Spec.Selector.MatchLabels) > 0 {
+		sb.WriteString("\nSelector:\n")
+		for k, v := range rs.Spec.Selector.MatchLabels {
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", k, v))
+		}
+	}
+
+	sb.WriteString("\nContainers:\n")
+	for _, container := range rs.Spec.Template.Spec.Containers {
+		sb.WriteString(fmt.Sprintf("  Name: %s\n", container.Name))
+		sb.WriteString(fmt.Sprintf("  Image: %s\n", container.Image))
+	}
+
+	sb.WriteString("\nPods:\n")
+	if len(pods) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, pod := range pods {
+		node := pod.Spec.NodeName
+		if node == "" {
+			node = "<none>"
+		}
+		sb.WriteString(fmt.Sprintf("  %s: %s, node %s\n", pod.Name, pod.Status.Phase, node))
+	}
+
+	if len(rs.Status.Conditions) > 0 {
+		sb.WriteString("\nConditions:\n")
+		for _, cond := range rs.Status.Conditions {
+			sb.WriteString(fmt.Sprintf("  %s: %s (Reason: %s)\n", cond.Type, cond.Status, cond.Reason))
+		}
+	}
+
+	return sb.String()
+}
+
+func ownerString(rs *appsv1.ReplicaSet) string {
+	if name := ownerDeployment(rs); name != "" {
+		return "Deployment/" + name
+	}
+	for _, ref := range rs.OwnerReferences {
+		return ref.Kind + "/" + ref.Name
+	}
+	return "<none>"
+}
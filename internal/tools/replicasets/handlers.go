@@ -0,0 +1,115 @@
+package replicasets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newListReplicaSetsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+		deployment := utils.GetStringArg(args, "deployment", "")
+		includeEmpty := utils.GetBoolArg(args, "includeEmpty", true)
+
+		list, err := c.Kubernetes.AppsV1().ReplicaSets(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list replicasets: %v", err)), nil
+		}
+
+		items := list.Items[:0]
+		for _, rs := range list.Items {
+			if deployment != "" && ownerDeployment(&rs) != deployment {
+				continue
+			}
+			if !includeEmpty && desiredReplicas(&rs) == 0 && rs.Status.Replicas == 0 {
+				continue
+			}
+			items = append(items, rs)
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].Namespace != items[j].Namespace {
+				return items[i].Namespace < items[j].Namespace
+			}
+			return items[i].CreationTimestamp.After(items[j].CreationTimestamp.Time)
+		})
+		list.Items = items
+
+		return mcp.NewToolResultText(formatReplicaSetsList(list)), nil
+	}
+}
+
+func newGetReplicaSetHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		rs, err := c.Kubernetes.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get replicaset %s/%s: %v", ns, name, err)), nil
+		}
+
+		pods, err := replicaSetPods(ctx, c, rs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list pods of replicaset %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(formatReplicaSetDetails(rs, pods)), nil
+	}
+}
+
+// replicaSetPods lista os pods controlados pelo ReplicaSet.
+func replicaSetPods(ctx context.Context, c *clients.Clients, rs *appsv1.ReplicaSet) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(rs.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.Kubernetes.CoreV1().Pods(rs.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []corev1.Pod
+	for _, pod := range list.Items {
+		if owner := metav1.GetControllerOf(&pod); owner != nil && owner.UID == rs.UID {
+			pods = append(pods, pod)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	return pods, nil
+}
+
+// ownerDeployment devolve o nome do Deployment que controla o ReplicaSet, ou "".
+func ownerDeployment(rs *appsv1.ReplicaSet) string {
+	if owner := metav1.GetControllerOf(rs); owner != nil && owner.Kind == "Deployment" {
+		return owner.Name
+	}
+	return ""
+}
+
+func desiredReplicas(rs *appsv1.ReplicaSet) int32 {
+	if rs.Spec.Replicas != nil {
+		return *rs.Spec.Replicas
+	}
+	return 1
+}
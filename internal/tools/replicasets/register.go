@@ -0,0 +1,54 @@
+package replicasets
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_replicasets",
+		Description: "List ReplicaSets with their owning Deployment and revision",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list ReplicaSets from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter ReplicaSets",
+				},
+				"deployment": map[string]interface{}{
+					"type":        "string",
+					"description": "Only ReplicaSets owned by this Deployment",
+				},
+				"includeEmpty": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Include ReplicaSets scaled to zero (old revisions); default true",
+				},
+			},
+		},
+	}, newListReplicaSetsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "get_replicaset",
+		Description: "Get a ReplicaSet with its owning Deployment, revision and pods",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the ReplicaSet",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the ReplicaSet",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newGetReplicaSetHandler(clients))
+}
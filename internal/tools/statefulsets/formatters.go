@@ -0,0 +1,115 @@
+package statefulsets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func formatStatefulSetsList(list *appsv1.StatefulSetList) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total StatefulSets: %d\n\n", len(list.Items)))
+
+	for _, sts := range list.Items {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", sts.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", sts.Namespace))
+		sb.WriteString(fmt.Sprintf("Replicas: %d/%d\n", sts.Status.ReadyReplicas, desiredReplicas(&sts)))
+		sb.WriteString(fmt.Sprintf("Updated: %d\n", sts.Status.UpdatedReplicas))
+		sb.WriteString(fmt.Sprintf("Service: %s\n", sts.Spec.ServiceName))
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatStatefulSetDetails(sts *appsv1.StatefulSet, pods []ordinalPod) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("StatefulSet: %s\n", sts.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", sts.Namespace))
+	sb.WriteString(fmt.Sprintf("Service: %s\n", sts.Spec.ServiceName))
+	sb.WriteString(fmt.Sprintf("Replicas: %d desired, %d current, %d ready, %d updated, %d available\n",
+		desiredReplicas(sts), sts.Status.CurrentReplicas, sts.Status.ReadyReplicas, sts.Status.UpdatedReplicas, sts.Status.AvailableReplicas))
+	sb.WriteString(fmt.Sprintf("Pod Management Policy: %s\n", sts.Spec.PodManagementPolicy))
+
+	sb.WriteString(fmt.Sprintf("\nUpdate Strategy: %s\n", sts.Spec.UpdateStrategy.Type))
+	if sts.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		partition := partitionOf(sts)
+		sb.WriteString(fmt.Sprintf("  Partition: %d", partition))
+		if partition > 0 {
+			sb.WriteString(fmt.Sprintf(" (only ordinals >= %d receive updates; lower ordinals stay on the current revision)", partition))
+		}
+		sb.WriteString("\n")
+		if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.MaxUnavailable != nil {
+			sb.WriteString(fmt.Sprintf("  Max Unavailable: %s\n", ru.MaxUnavailable.String()))
+		}
+	}
+	sb.WriteString(fmt.Sprintf("  Current Revision: %s\n", sts.Status.CurrentRevision))
+	sb.WriteString(fmt.Sprintf("  Update Revision: %s\n", sts.Status.UpdateRevision))
+	if sts.Status.CurrentRevision != sts.Status.UpdateRevision && sts.Status.UpdateRevision != "" {
+		sb.WriteString("  Rolling update in progress\n")
+	}
+
+	if len(sts.Spec.VolumeClaimTemplates) > 0 {
+		sb.WriteString("\nVolume Claim Templates:\n")
+		for _, vct := range sts.Spec.VolumeClaimTemplates {
+			size := vct.Spec.Resources.Requests[corev1.ResourceStorage]
+			class := "<default>"
+			if vct.Spec.StorageClassName != nil {
+				class = *vct.Spec.StorageClassName
+			}
+			sb.WriteString(fmt.Sprintf("  %s: %s, storage class %s\n", vct.Name, size.String(), class))
+		}
+	}
+
+	sb.WriteString("\nContainers:\n")
+	for _, container := range sts.Spec.Template.Spec.Containers {
+		sb.WriteString(fmt.Sprintf("  Name: %s\n", container.Name))
+		sb.WriteString(fmt.Sprintf("  Image: %s\n", container.Image))
+	}
+
+	sb.WriteString("\nPods:\n")
+	if len(pods) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, op := range pods {
+		pod := op.pod
+		revision := pod.Labels[appsv1.StatefulSetRevisionLabel]
+		switch revision {
+		case sts.Status.UpdateRevision:
+			revision += " (updated)"
+		case sts.Status.CurrentRevision:
+			revision += " (current)"
+		}
+		sb.WriteString(fmt.Sprintf("  [%d] %s: %s, ready %s, restarts %d, node %s, revision %s\n",
+			op.ordinal, pod.Name, utils.PodPhase(pod), utils.PodReadyCount(pod), utils.PodRestartCount(pod), nodeOrNone(pod), revision))
+	}
+
+	if len(sts.Status.Conditions) > 0 {
+		sb.WriteString("\nConditions:\n")
+		for _, cond := range sts.Status.Conditions {
+			sb.WriteString(fmt.Sprintf("  %s: %s (Reason: %s)\n", cond.Type, cond.Status, cond.Reason))
+		}
+	}
+
+	return sb.String()
+}
+
+func desiredReplicas(sts *appsv1.StatefulSet) int32 {
+	if sts.Spec.Replicas != nil {
+		return *sts.Spec.Replicas
+	}
+	return 1
+}
+
+func nodeOrNone(pod *corev1.Pod) string {
+	if pod.Spec.NodeName == "" {
+		return "<none>"
+	}
+	return pod.Spec.NodeName
+}
@@ -0,0 +1,175 @@
+package statefulsets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newListStatefulSetsHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+
+		list, err := c.Kubernetes.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: utils.GetStringArg(args, "labelSelector", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list statefulsets: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatStatefulSetsList(list)), nil
+	}
+}
+
+func newGetStatefulSetHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		sts, err := c.Kubernetes.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get statefulset %s/%s: %v", ns, name, err)), nil
+		}
+
+		pods, err := statefulSetPods(ctx, c, sts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list pods of statefulset %s/%s: %v", ns, name, err)), nil
+		}
+
+		return mcp.NewToolResultText(formatStatefulSetDetails(sts, pods)), nil
+	}
+}
+
+func newScaleStatefulSetHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+		if _, ok := args["replicas"]; !ok {
+			return mcp.NewToolResultError("replicas is required"), nil
+		}
+		replicas := utils.GetIntArg(args, "replicas", 0)
+		if replicas < 0 {
+			return mcp.NewToolResultError("replicas must be >= 0"), nil
+		}
+
+		scale, err := c.Kubernetes.AppsV1().StatefulSets(ns).GetScale(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get scale of statefulset %s/%s: %v", ns, name, err)), nil
+		}
+		previous := scale.Spec.Replicas
+
+		scale.Spec = autoscalingv1.ScaleSpec{Replicas: int32(replicas)}
+		if _, err := c.Kubernetes.AppsV1().StatefulSets(ns).UpdateScale(ctx, name, scale, metav1.UpdateOptions{}); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to scale statefulset %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("StatefulSet %s/%s scaled from %d to %d replicas", ns, name, previous, replicas)
+		if int32(replicas) < previous {
+			msg += "\nPods are removed from the highest ordinal down; their PersistentVolumeClaims are kept unless the retention policy says otherwise."
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+func newRestartStatefulSetHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, ok := req.Params.Arguments.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		ns := utils.GetStringArg(args, "namespace", "")
+		if name == "" || ns == "" {
+			return mcp.NewToolResultError("name and namespace are required"), nil
+		}
+
+		patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`,
+			time.Now().Format(time.RFC3339))
+		sts, err := c.Kubernetes.AppsV1().StatefulSets(ns).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to restart statefulset %s/%s: %v", ns, name, err)), nil
+		}
+
+		msg := fmt.Sprintf("StatefulSet %s/%s restarted", ns, name)
+		switch {
+		case sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType:
+			msg += "\nupdateStrategy is OnDelete: pods are only restarted when you delete them."
+		case partitionOf(sts) > 0:
+			msg += fmt.Sprintf("\nRolling update partition is %d: only pods with ordinal >= %d are restarted.", partitionOf(sts), partitionOf(sts))
+		}
+		return mcp.NewToolResultText(msg), nil
+	}
+}
+
+// ordinalPod é um pod do StatefulSet com o ordinal extraído do nome.
+type ordinalPod struct {
+	ordinal int
+	pod     *corev1.Pod
+}
+
+// statefulSetPods lista os pods controlados pelo StatefulSet, ordenados por ordinal.
+func statefulSetPods(ctx context.Context, c *clients.Clients, sts *appsv1.StatefulSet) ([]ordinalPod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.Kubernetes.CoreV1().Pods(sts.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []ordinalPod
+	for i := range list.Items {
+		pod := &list.Items[i]
+		if owner := metav1.GetControllerOf(pod); owner == nil || owner.UID != sts.UID {
+			continue
+		}
+		suffix := strings.TrimPrefix(pod.Name, sts.Name+"-")
+		ordinal, err := strconv.Atoi(suffix)
+		if err != nil {
+			ordinal = -1
+		}
+		pods = append(pods, ordinalPod{ordinal: ordinal, pod: pod})
+	}
+	sort.Slice(pods, func(i, j int) bool { return pods[i].ordinal < pods[j].ordinal })
+	return pods, nil
+}
+
+func partitionOf(sts *appsv1.StatefulSet) int32 {
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		return *ru.Partition
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package statefulsets
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_statefulsets",
+		Description: "List StatefulSets in a namespace or across all namespaces",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list StatefulSets from (empty for all namespaces)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter StatefulSets",
+				},
+			},
+		},
+	}, newListStatefulSetsHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "get_statefulset",
+		Description: "Get a StatefulSet with its update strategy (including the rolling update partition), revisions and the status of each pod by ordinal",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the StatefulSet",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the StatefulSet",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newGetStatefulSetHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "scale_statefulset",
+		Description: "Scale a StatefulSet to the specified number of replicas",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the StatefulSet",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the StatefulSet",
+				},
+				"replicas": map[string]interface{}{
+					"type":        "integer",
+					"description": "Target number of replicas",
+					"minimum":     0,
+				},
+			},
+			Required: []string{"name", "namespace", "replicas"},
+		},
+	}, newScaleStatefulSetHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "restart_statefulset",
+		Description: "Restart a StatefulSet by updating its pod template annotation (pods are replaced in reverse ordinal order)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the StatefulSet",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the StatefulSet",
+				},
+			},
+			Required: []string{"name", "namespace"},
+		},
+	}, newRestartStatefulSetHandler(clients))
+}
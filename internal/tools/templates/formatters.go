@@ -0,0 +1,131 @@
+package templates
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/kube"
+
+	templatev1 "github.com/openshift/api/template/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+func formatTemplatesList(templates []templatev1.Template) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total Templates: %d\n\n", len(templates)))
+	for _, t := range templates {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", t.Name))
+		sb.WriteString(fmt.Sprintf("Namespace: %s\n", t.Namespace))
+		if display := t.Annotations["openshift.io/display-name"]; display != "" {
+			sb.WriteString(fmt.Sprintf("Display Name: %s\n", display))
+		}
+		if desc := t.Annotations["description"]; desc != "" {
+			sb.WriteString(fmt.Sprintf("Description: %s\n", firstLineOf(desc)))
+		}
+		sb.WriteString(fmt.Sprintf("Parameters: %d, Objects: %d\n", len(t.Parameters), len(t.Objects)))
+		sb.WriteString("\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+func formatTemplateDetails(tmpl *templatev1.Template) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Template: %s\n", tmpl.Name))
+	sb.WriteString(fmt.Sprintf("Namespace: %s\n", tmpl.Namespace))
+	for _, key := range []string{"openshift.io/display-name", "description", "tags", "openshift.io/provider-display-name", "openshift.io/documentation-url"} {
+		if v := tmpl.Annotations[key]; v != "" {
+			sb.WriteString(fmt.Sprintf("%s: %s\n", key, strings.TrimSpace(v)))
+		}
+	}
+
+	sb.WriteString("\nParameters:\n")
+	if len(tmpl.Parameters) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, p := range tmpl.Parameters {
+		sb.WriteString(fmt.Sprintf("  %s", p.Name))
+		var flags []string
+		if p.Required {
+			flags = append(flags, "required")
+		}
+		if p.Generate != "" {
+			flags = append(flags, fmt.Sprintf("generated: %s %q", p.Generate, p.From))
+		}
+		if len(flags) > 0 {
+			sb.WriteString(fmt.Sprintf(" (%s)", strings.Join(flags, ", ")))
+		}
+		sb.WriteString("\n")
+		if p.DisplayName != "" {
+			sb.WriteString(fmt.Sprintf("    Display Name: %s\n", p.DisplayName))
+		}
+		if p.Description != "" {
+			sb.WriteString(fmt.Sprintf("    Description: %s\n", strings.TrimSpace(p.Description)))
+		}
+		if p.Value != "" {
+			sb.WriteString(fmt.Sprintf("    Default: %s\n", p.Value))
+		}
+	}
+
+	if len(tmpl.ObjectLabels) > 0 {
+		sb.WriteString("\nObject Labels:\n")
+		for _, k := range sortedKeys(tmpl.ObjectLabels) {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", k, tmpl.ObjectLabels[k]))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\nObjects (%d):\n", len(tmpl.Objects)))
+	for _, ext := range tmpl.Objects {
+		var obj unstructured.Unstructured
+		if err := obj.UnmarshalJSON(ext.Raw); err != nil {
+			sb.WriteString("  <unparseable object>\n")
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  %s %s\n", obj.GetKind(), obj.GetName()))
+	}
+
+	if tmpl.Message != "" {
+		sb.WriteString(fmt.Sprintf("\nMessage (shown after processing):\n%s\n", strings.TrimSpace(tmpl.Message)))
+	}
+
+	return sb.String()
+}
+
+// formatProcessed devolve os objetos renderizados em YAML (process_template sem apply).
+func formatProcessed(tmpl *templatev1.Template, objs []*unstructured.Unstructured, targetNS, message string) (string, error) {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Processed template %s/%s into %d object(s) for namespace %s (not applied)\n", tmpl.Namespace, tmpl.Name, len(objs), targetNS))
+	for _, obj := range objs {
+		out, err := sigyaml.Marshal(obj.Object)
+		if err != nil {
+			return "", fmt.Errorf("Failed to render %s: %w", kube.ObjectRef(obj), err)
+		}
+		sb.WriteString("---\n")
+		sb.Write(out)
+	}
+	if message != "" {
+		sb.WriteString(fmt.Sprintf("\nMessage:\n%s\n", strings.TrimSpace(message)))
+	}
+	return sb.String(), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func firstLineOf(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i] + " ..."
+	}
+	return s
+}
@@ -0,0 +1,320 @@
+package templates
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/kube"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	templatev1 "github.com/openshift/api/template/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	templatesGVR          = schema.GroupVersionResource{Group: "template.openshift.io", Version: "v1", Resource: "templates"}
+	processedTemplatesGVR = schema.GroupVersionResource{Group: "template.openshift.io", Version: "v1", Resource: "processedtemplates"}
+)
+
+// sharedTemplatesNamespace é onde o OpenShift publica os templates do catálogo.
+const sharedTemplatesNamespace = "openshift"
+
+func newListTemplatesHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args, _ := req.Params.Arguments.(map[string]any)
+		ns := utils.GetStringArg(args, "namespace", "")
+		opts := metav1.ListOptions{LabelSelector: utils.GetStringArg(args, "labelSelector", "")}
+
+		namespaces := []string{ns}
+		if ns != "" && ns != sharedTemplatesNamespace && utils.GetBoolArg(args, "includeShared", true) {
+			namespaces = append(namespaces, sharedTemplatesNamespace)
+		}
+
+		var templates []templatev1.Template
+		for _, n := range namespaces {
+			list, err := listTemplates(ctx, c, n, opts)
+			if err != nil {
+				// Sem acesso ao namespace compartilhado não é motivo para falhar a listagem toda
+				if n != ns && (apierrors.IsForbidden(err) || apierrors.IsNotFound(err)) {
+					continue
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list templates in %q: %v", n, err)), nil
+			}
+			templates = append(templates, list...)
+		}
+		sort.SliceStable(templates, func(i, j int) bool {
+			if templates[i].Namespace != templates[j].Namespace {
+				return templates[i].Namespace < templates[j].Namespace
+			}
+			return templates[i].Name < templates[j].Name
+		})
+
+		return mcp.NewToolResultText(formatTemplatesList(templates)), nil
+	}
+}
+
+func newDescribeTemplateHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+		name := utils.GetStringArg(args, "name", "")
+		if name == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+
+		_, tmpl, err := getTemplate(ctx, c, utils.GetStringArg(args, "namespace", ""), name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get template %s: %v", name, err)), nil
+		}
+
+		return mcp.NewToolResultText(formatTemplateDetails(tmpl)), nil
+	}
+}
+
+func newProcessTemplateHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+		name := utils.GetStringArg(args, "name", "")
+		if name == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		apply := utils.GetBoolArg(args, "apply", false)
+		// nunca cai no namespace do template (ex.: "openshift"): aplicar exige alvo explícito
+		targetNS := utils.GetStringArg(args, "targetNamespace", "")
+		if targetNS == "" {
+			if apply {
+				return mcp.NewToolResultError("targetNamespace is required when apply is true"), nil
+			}
+			targetNS = metav1.NamespaceDefault
+		}
+		dryRun, err := kube.ParseDryRun(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		params, err := stringMapArg(args, "parameters")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		labels, err := stringMapArg(args, "labels")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		u, tmpl, err := getTemplate(ctx, c, utils.GetStringArg(args, "namespace", ""), name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get template %s: %v", name, err)), nil
+		}
+		if err := checkTemplateParameters(tmpl, params); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if err := setTemplateInputs(u, params, labels); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to set template parameters: %v", err)), nil
+		}
+
+		// processedtemplates renderiza no namespace do request, então o template vai para o alvo
+		u.SetNamespace(targetNS)
+		u.SetResourceVersion("")
+		u.SetUID("")
+		processed, err := c.Dynamic.Resource(processedTemplatesGVR).Namespace(targetNS).Create(ctx, u, metav1.CreateOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to process template %s: %v", name, err)), nil
+		}
+
+		items, _, err := unstructured.NestedSlice(processed.Object, "objects")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read processed objects: %v", err)), nil
+		}
+		objs := make([]*unstructured.Unstructured, 0, len(items))
+		for _, item := range items {
+			m, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			objs = append(objs, &unstructured.Unstructured{Object: m})
+		}
+		message, _, _ := unstructured.NestedString(processed.Object, "message")
+
+		if !apply {
+			text, err := formatProcessed(tmpl, objs, targetNS, message)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			return mcp.NewToolResultText(text), nil
+		}
+
+		var buf bytes.Buffer
+		fieldManager := utils.GetStringArg(args, "fieldManager", kube.DefaultFieldManager)
+		fmt.Fprintf(&buf, "Applied template %s/%s to namespace %s: %d object(s) (fieldManager: %s, dryRun: %s)\n\n",
+			tmpl.Namespace, tmpl.Name, targetNS, len(objs), fieldManager, kube.DryRunLabel(dryRun))
+
+		failed := 0
+		for i, obj := range objs {
+			res, _, err := kube.ResourceForObject(c, obj, targetNS)
+			if err != nil {
+				failed++
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n", i+1, kube.ObjectRef(obj), err)
+				continue
+			}
+			outcome, conflicts, err := kube.ApplyObject(ctx, res, obj, fieldManager, false, dryRun)
+			if err != nil {
+				failed++
+				fmt.Fprintf(&buf, "[%d] %s: error: %v\n", i+1, kube.ObjectRef(obj), err)
+				for _, cf := range conflicts {
+					fmt.Fprintf(&buf, "    Conflict: %s\n", cf)
+				}
+				continue
+			}
+			fmt.Fprintf(&buf, "[%d] %s: %s\n", i+1, kube.ObjectRef(obj), outcome)
+		}
+
+		if failed > 0 {
+			fmt.Fprintf(&buf, "\n%d of %d object(s) failed.\n", failed, len(objs))
+			if failed == len(objs) {
+				return mcp.NewToolResultError(buf.String()), nil
+			}
+		}
+		if message != "" {
+			fmt.Fprintf(&buf, "\nMessage:\n%s\n", strings.TrimSpace(message))
+		}
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+}
+
+func listTemplates(ctx context.Context, c *clients.Clients, ns string, opts metav1.ListOptions) ([]templatev1.Template, error) {
+	ul, err := c.Dynamic.Resource(templatesGVR).Namespace(ns).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &templatev1.TemplateList{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(ul.UnstructuredContent(), list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// getTemplate busca o template no namespace pedido e, se não existir lá, no namespace
+// compartilhado "openshift" (como oc process faz com templates do catálogo).
+func getTemplate(ctx context.Context, c *clients.Clients, ns, name string) (*unstructured.Unstructured, *templatev1.Template, error) {
+	if ns == "" {
+		ns = sharedTemplatesNamespace
+	}
+	u, err := c.Dynamic.Resource(templatesGVR).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) && ns != sharedTemplatesNamespace {
+		u, err = c.Dynamic.Resource(templatesGVR).Namespace(sharedTemplatesNamespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tmpl := &templatev1.Template{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, tmpl); err != nil {
+		return nil, nil, err
+	}
+	return u, tmpl, nil
+}
+
+// checkTemplateParameters recusa parâmetros desconhecidos e aponta os obrigatórios sem valor
+// antes de chamar a API, com uma mensagem melhor que a do servidor.
+func checkTemplateParameters(tmpl *templatev1.Template, params map[string]string) error {
+	known := map[string]bool{}
+	var missing []string
+	for _, p := range tmpl.Parameters {
+		known[p.Name] = true
+		if p.Required && p.Value == "" && p.Generate == "" && params[p.Name] == "" {
+			missing = append(missing, p.Name)
+		}
+	}
+
+	var unknown []string
+	for k := range params {
+		if !known[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(unknown)
+
+	switch {
+	case len(unknown) > 0:
+		return fmt.Errorf("unknown template parameter(s): %s", strings.Join(unknown, ", "))
+	case len(missing) > 0:
+		return fmt.Errorf("missing required template parameter(s): %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// setTemplateInputs grava os valores dos parâmetros e os labels extras no template.
+func setTemplateInputs(u *unstructured.Unstructured, params, labels map[string]string) error {
+	if len(params) > 0 {
+		items, _, err := unstructured.NestedSlice(u.Object, "parameters")
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			p, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			if v, ok := params[fmt.Sprint(p["name"])]; ok {
+				p["value"] = v
+			}
+		}
+		if err := unstructured.SetNestedSlice(u.Object, items, "parameters"); err != nil {
+			return err
+		}
+	}
+
+	if len(labels) > 0 {
+		merged, _, err := unstructured.NestedStringMap(u.Object, "labels")
+		if err != nil {
+			return err
+		}
+		if merged == nil {
+			merged = map[string]string{}
+		}
+		for k, v := range labels {
+			merged[k] = v
+		}
+		if err := unstructured.SetNestedStringMap(u.Object, merged, "labels"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stringMapArg lê um argumento objeto NOME -> valor; valores não string são formatados.
+func stringMapArg(args map[string]any, key string) (map[string]string, error) {
+	raw, ok := args[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	m, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object of NAME: value", key)
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		} else {
+			out[k] = fmt.Sprint(v)
+		}
+	}
+	return out, nil
+}
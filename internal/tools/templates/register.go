@@ -0,0 +1,93 @@
+package templates
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "list_templates",
+		Description: "List OpenShift Templates of a namespace and of the shared \"openshift\" namespace",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace to list Templates from (empty for all namespaces)",
+				},
+				"includeShared": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Also list the templates of the shared \"openshift\" namespace (default true)",
+				},
+				"labelSelector": map[string]interface{}{
+					"type":        "string",
+					"description": "Label selector to filter Templates",
+				},
+			},
+		},
+	}, newListTemplatesHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "describe_template",
+		Description: "Describe an OpenShift Template: its parameters with defaults, generators and required flags, labels and the objects it creates",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the Template",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the Template (default \"openshift\"; falls back to \"openshift\" when not found)",
+				},
+			},
+			Required: []string{"name"},
+		},
+	}, newDescribeTemplateHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "process_template",
+		Description: "Render an OpenShift Template with the processedtemplates API and return the objects as YAML; with apply, create them in targetNamespace using server-side apply (like oc new-app --template)",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the Template",
+				},
+				"namespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace of the Template (default \"openshift\")",
+				},
+				"targetNamespace": map[string]interface{}{
+					"type":        "string",
+					"description": "Namespace for the objects; required when apply is true, never taken from the Template namespace (without apply the template is only rendered, in \"default\" when omitted)",
+				},
+				"parameters": map[string]interface{}{
+					"type":        "object",
+					"description": "Template parameters, as NAME: value",
+				},
+				"labels": map[string]interface{}{
+					"type":        "object",
+					"description": "Labels added to every object",
+				},
+				"apply": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Create the processed objects with server-side apply",
+				},
+				"fieldManager": map[string]interface{}{
+					"type":        "string",
+					"description": "Field manager for the apply (default openshift-mcp)",
+				},
+				"dryRun": map[string]interface{}{
+					"type":        "string",
+					"description": "\"server\" to validate on the server without persisting",
+				},
+			},
+			Required: []string{"name"},
+		},
+	}, newProcessTemplateHandler(clients))
+}
@@ -0,0 +1,74 @@
+package workloads
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// formatSummary lista as mudanças por container e se elas disparam rollout.
+func formatSummary(changes []string, after *unstructured.Unstructured, dryRun bool) string {
+	var sb strings.Builder
+	sb.WriteString("\nSummary:\n")
+	for _, change := range changes {
+		sb.WriteString(fmt.Sprintf("  - %s\n", change))
+	}
+	sb.WriteString(fmt.Sprintf("\nRollout: %s\n", rolloutTriggerNote(after, dryRun)))
+	return sb.String()
+}
+
+// rolloutTriggerNote explica se (e como) a mudança no template vai gerar um rollout.
+func rolloutTriggerNote(obj *unstructured.Unstructured, dryRun bool) string {
+	if dryRun {
+		return "none (server dry run; nothing was persisted)."
+	}
+
+	switch obj.GetKind() {
+	case "Deployment":
+		if paused, _, _ := unstructured.NestedBool(obj.Object, "spec", "paused"); paused {
+			return "the Deployment is paused; the change rolls out when it is resumed."
+		}
+		return "a new ReplicaSet is being rolled out; follow it with rollout_status."
+	case "StatefulSet", "DaemonSet":
+		if strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type"); strategy == "OnDelete" {
+			return "updateStrategy is OnDelete; pods pick up the change only when they are deleted."
+		}
+		return "pods are being replaced by the RollingUpdate strategy."
+	case "DeploymentConfig":
+		triggers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "triggers")
+		configChange := false
+		var imageTriggered []string
+		for _, t := range triggers {
+			tm, _ := t.(map[string]any)
+			switch tm["type"] {
+			case "ConfigChange":
+				configChange = true
+			case "ImageChange":
+				names, _, _ := unstructured.NestedStringSlice(tm, "imageChangeParams", "containerNames")
+				imageTriggered = append(imageTriggered, names...)
+			}
+		}
+		note := "no ConfigChange trigger; start a new deployment manually (oc rollout latest)."
+		if configChange {
+			note = "the ConfigChange trigger starts a new deployment."
+		}
+		if len(imageTriggered) > 0 {
+			note += fmt.Sprintf(" Containers %s have ImageChange triggers, which overwrite their image when the ImageStreamTag changes.", strings.Join(imageTriggered, ", "))
+		}
+		return note
+	}
+	return "unknown."
+}
+
+func containerNames(tmpl *corev1.PodTemplateSpec) []string {
+	var names []string
+	for _, ctr := range tmpl.Spec.InitContainers {
+		names = append(names, ctr.Name+" (init)")
+	}
+	for _, ctr := range tmpl.Spec.Containers {
+		names = append(names, ctr.Name)
+	}
+	return names
+}
@@ -0,0 +1,202 @@
+package workloads
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/kube"
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// SET IMAGE / ENV / RESOURCES (pod template de workloads, como o kubectl set)
+///////////////////////////////////////////////////////////////////////////////
+
+// templateWorkloads são os kinds cujo pod template fica em spec.template.
+var templateWorkloads = map[schema.GroupKind]bool{
+	{Group: "apps", Kind: "Deployment"}:                    true,
+	{Group: "apps", Kind: "StatefulSet"}:                   true,
+	{Group: "apps", Kind: "DaemonSet"}:                     true,
+	{Group: "apps.openshift.io", Kind: "DeploymentConfig"}: true,
+}
+
+// templateMutator altera o pod template e devolve uma descrição curta de cada mudança.
+type templateMutator func(tmpl *corev1.PodTemplateSpec) ([]string, error)
+
+func newSetImageHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return workloadTemplateHandler(c, "image updated", setImageMutator)
+}
+
+func newSetEnvHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return workloadTemplateHandler(c, "env updated", setEnvMutator)
+}
+
+func newSetResourcesHandler(c *clients.Clients) mcpsrv.ToolHandlerFunc {
+	return workloadTemplateHandler(c, "resources updated", setResourcesMutator)
+}
+
+// workloadTemplateHandler faz get, aplica o mutator numa cópia do pod template e envia um
+// JSON patch só com os campos alterados de cada container, sem regravar o objeto inteiro.
+func workloadTemplateHandler(c *clients.Clients, action string, mutator func(args map[string]any) (templateMutator, error)) mcpsrv.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		raw := req.Params.Arguments
+		args, ok := raw.(map[string]any)
+		if !ok {
+			return mcp.NewToolResultError("invalid arguments payload (expected object)"), nil
+		}
+
+		name := utils.GetStringArg(args, "name", "")
+		if name == "" {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+		dryRun, err := kube.ParseDryRun(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		mutate, err := mutator(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		mapping, res, err := kube.ResourceClientFromArgs(c, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		gk := mapping.GroupVersionKind.GroupKind()
+		if !templateWorkloads[gk] {
+			return mcp.NewToolResultError(fmt.Sprintf("%s is not supported (use Deployment, StatefulSet, DaemonSet or DeploymentConfig)", gk.Kind)), nil
+		}
+
+		before, err := res.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get %s %s: %v", gk.Kind, name, err)), nil
+		}
+
+		orig, err := podTemplateOf(before)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tmpl := orig.DeepCopy()
+		changes, err := mutate(tmpl)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if len(changes) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("%s: no changes.", kube.ObjectRef(before))), nil
+		}
+
+		patch, err := podTemplatePatch(orig, tmpl)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		after, err := res.Patch(ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{FieldManager: kube.DefaultFieldManager, DryRun: dryRun})
+		if apierrors.IsInvalid(err) && containersChanged(ctx, res, name, orig) {
+			// o "test" do nome falhou: containers foram reordenados/removidos desde o get
+			return mcp.NewToolResultError(fmt.Sprintf("%s changed concurrently; retry the call: %v", kube.ObjectRef(before), err)), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to patch %s %s: %v", gk.Kind, name, err)), nil
+		}
+
+		text, err := kube.FormatChange(before, after, action, dryRun)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to compute diff: %v", err)), nil
+		}
+		return mcp.NewToolResultText(text + formatSummary(changes, after, len(dryRun) > 0)), nil
+	}
+}
+
+// containersChanged relê o objeto para distinguir a falha do "test" do JSON patch
+// (lista de containers mudou desde o get) de um erro de validação da API.
+func containersChanged(ctx context.Context, res dynamic.ResourceInterface, name string, orig *corev1.PodTemplateSpec) bool {
+	live, err := res.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+	tmpl, err := podTemplateOf(live)
+	if err != nil {
+		return false
+	}
+	return !slices.Equal(containerNames(tmpl), containerNames(orig))
+}
+
+func podTemplateOf(obj *unstructured.Unstructured) (*corev1.PodTemplateSpec, error) {
+	raw, found, err := unstructured.NestedMap(obj.Object, "spec", "template")
+	if err != nil || !found {
+		return nil, fmt.Errorf("%s has no spec.template", kube.ObjectRef(obj))
+	}
+	tmpl := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, tmpl); err != nil {
+		return nil, fmt.Errorf("failed to decode pod template of %s: %w", kube.ObjectRef(obj), err)
+	}
+	return tmpl, nil
+}
+
+// podTemplatePatch monta um JSON patch com os campos alterados (image, env, envFrom,
+// resources) de cada container. Cada container é endereçado pelo índice, protegido por
+// um "test" do nome para o patch falhar se a lista mudou desde a leitura.
+func podTemplatePatch(before, after *corev1.PodTemplateSpec) ([]byte, error) {
+	var ops []map[string]any
+	lists := []struct {
+		path          string
+		before, after []corev1.Container
+	}{
+		{"/spec/template/spec/initContainers", before.Spec.InitContainers, after.Spec.InitContainers},
+		{"/spec/template/spec/containers", before.Spec.Containers, after.Spec.Containers},
+	}
+	for _, l := range lists {
+		for i := range l.after {
+			old, cur := &l.before[i], &l.after[i]
+			prefix := fmt.Sprintf("%s/%d", l.path, i)
+			fields := []struct {
+				name       string
+				old, cur   any
+				emptyAfter bool
+			}{
+				{"image", old.Image, cur.Image, cur.Image == ""},
+				{"env", old.Env, cur.Env, len(cur.Env) == 0},
+				{"envFrom", old.EnvFrom, cur.EnvFrom, len(cur.EnvFrom) == 0},
+				{"resources", old.Resources, cur.Resources, len(cur.Resources.Requests) == 0 && len(cur.Resources.Limits) == 0 && len(cur.Resources.Claims) == 0},
+			}
+
+			var containerOps []map[string]any
+			for _, f := range fields {
+				if equality.Semantic.DeepEqual(f.old, f.cur) {
+					continue
+				}
+				path := prefix + "/" + f.name
+				if f.emptyAfter {
+					containerOps = append(containerOps, map[string]any{"op": "remove", "path": path})
+					continue
+				}
+				// "add" num membro de objeto cria ou substitui
+				containerOps = append(containerOps, map[string]any{"op": "add", "path": path, "value": f.cur})
+			}
+			if len(containerOps) > 0 {
+				ops = append(ops, map[string]any{"op": "test", "path": prefix + "/name", "value": cur.Name})
+				ops = append(ops, containerOps...)
+			}
+		}
+	}
+
+	data, err := json.Marshal(ops)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode patch: %w", err)
+	}
+	return data, nil
+}
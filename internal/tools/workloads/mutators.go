@@ -0,0 +1,289 @@
+package workloads
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fmendonca/openshift-mcp/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// selectContainers devolve os containers alvo: o nome pedido (regular ou init) ou todos os regulares.
+func selectContainers(tmpl *corev1.PodTemplateSpec, name string) ([]*corev1.Container, error) {
+	var out []*corev1.Container
+	for i := range tmpl.Spec.Containers {
+		if name == "" || name == "*" || tmpl.Spec.Containers[i].Name == name {
+			out = append(out, &tmpl.Spec.Containers[i])
+		}
+	}
+	if name != "" && name != "*" {
+		for i := range tmpl.Spec.InitContainers {
+			if tmpl.Spec.InitContainers[i].Name == name {
+				out = append(out, &tmpl.Spec.InitContainers[i])
+			}
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("container %q not found (available: %s)", name, strings.Join(containerNames(tmpl), ", "))
+	}
+	return out, nil
+}
+
+func setImageMutator(args map[string]any) (templateMutator, error) {
+	images := map[string]string{}
+	if m, ok := args["images"].(map[string]any); ok {
+		for k, v := range m {
+			s, ok := v.(string)
+			if !ok || s == "" {
+				return nil, fmt.Errorf("image for container %q must be a non-empty string", k)
+			}
+			images[k] = s
+		}
+	}
+	if img := utils.GetStringArg(args, "image", ""); img != "" {
+		images[utils.GetStringArg(args, "container", "*")] = img
+	}
+	if len(images) == 0 {
+		return nil, fmt.Errorf("images (container -> image) or image is required")
+	}
+
+	return func(tmpl *corev1.PodTemplateSpec) ([]string, error) {
+		var changes []string
+		keys := make([]string, 0, len(images))
+		for k := range images {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, name := range keys {
+			ctrs, err := selectContainers(tmpl, name)
+			if err != nil {
+				return nil, err
+			}
+			for _, ctr := range ctrs {
+				if ctr.Image != images[name] {
+					changes = append(changes, fmt.Sprintf("%s: %s -> %s", ctr.Name, ctr.Image, images[name]))
+					ctr.Image = images[name]
+				}
+			}
+		}
+		return changes, nil
+	}, nil
+}
+
+func setEnvMutator(args map[string]any) (templateMutator, error) {
+	var set []corev1.EnvVar
+	if m, ok := args["env"].(map[string]any); ok {
+		for name, v := range m {
+			ev := corev1.EnvVar{Name: name}
+			switch val := v.(type) {
+			case string:
+				ev.Value = val
+			case map[string]any:
+				data, _ := json.Marshal(val)
+				src := &corev1.EnvVarSource{}
+				if err := json.Unmarshal(data, src); err != nil {
+					return nil, fmt.Errorf("invalid valueFrom for %s: %w", name, err)
+				}
+				if src.ConfigMapKeyRef == nil && src.SecretKeyRef == nil && src.FieldRef == nil && src.ResourceFieldRef == nil {
+					return nil, fmt.Errorf("env %s: expected configMapKeyRef, secretKeyRef, fieldRef or resourceFieldRef", name)
+				}
+				ev.ValueFrom = src
+			default:
+				return nil, fmt.Errorf("env %s must be a string or a valueFrom object", name)
+			}
+			set = append(set, ev)
+		}
+	}
+	sort.Slice(set, func(i, j int) bool { return set[i].Name < set[j].Name })
+
+	var addFrom []corev1.EnvFromSource
+	prefix := utils.GetStringArg(args, "prefix", "")
+	if cm := utils.GetStringArg(args, "fromConfigMap", ""); cm != "" {
+		addFrom = append(addFrom, corev1.EnvFromSource{Prefix: prefix, ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: cm}}})
+	}
+	if sec := utils.GetStringArg(args, "fromSecret", ""); sec != "" {
+		addFrom = append(addFrom, corev1.EnvFromSource{Prefix: prefix, SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: sec}}})
+	}
+
+	remove := utils.InterfaceSliceToStringSlice(args["remove"])
+	removeFrom := utils.InterfaceSliceToStringSlice(args["removeFrom"])
+	for _, r := range removeFrom {
+		if !strings.HasPrefix(r, "configmap/") && !strings.HasPrefix(r, "secret/") {
+			return nil, fmt.Errorf("removeFrom entries must look like configmap/<name> or secret/<name>, got %q", r)
+		}
+	}
+	if len(set) == 0 && len(addFrom) == 0 && len(remove) == 0 && len(removeFrom) == 0 {
+		return nil, fmt.Errorf("env, fromConfigMap, fromSecret, remove or removeFrom is required")
+	}
+	container := utils.GetStringArg(args, "container", "")
+
+	return func(tmpl *corev1.PodTemplateSpec) ([]string, error) {
+		ctrs, err := selectContainers(tmpl, container)
+		if err != nil {
+			return nil, err
+		}
+
+		var changes []string
+		for _, ctr := range ctrs {
+			for _, ev := range set {
+				i := envIndex(ctr.Env, ev.Name)
+				switch {
+				case i < 0:
+					ctr.Env = append(ctr.Env, ev)
+					changes = append(changes, fmt.Sprintf("%s: added %s", ctr.Name, ev.Name))
+				case !envEqual(ctr.Env[i], ev):
+					ctr.Env[i] = ev
+					changes = append(changes, fmt.Sprintf("%s: updated %s", ctr.Name, ev.Name))
+				}
+			}
+			for _, name := range remove {
+				if i := envIndex(ctr.Env, name); i >= 0 {
+					ctr.Env = append(ctr.Env[:i], ctr.Env[i+1:]...)
+					changes = append(changes, fmt.Sprintf("%s: removed %s", ctr.Name, name))
+				}
+			}
+
+			for _, from := range addFrom {
+				if envFromIndex(ctr.EnvFrom, envFromRef(from)) < 0 {
+					ctr.EnvFrom = append(ctr.EnvFrom, from)
+					changes = append(changes, fmt.Sprintf("%s: added envFrom %s", ctr.Name, envFromRef(from)))
+				}
+			}
+			for _, ref := range removeFrom {
+				if i := envFromIndex(ctr.EnvFrom, ref); i >= 0 {
+					ctr.EnvFrom = append(ctr.EnvFrom[:i], ctr.EnvFrom[i+1:]...)
+					changes = append(changes, fmt.Sprintf("%s: removed envFrom %s", ctr.Name, ref))
+				}
+			}
+		}
+		return changes, nil
+	}, nil
+}
+
+func envIndex(env []corev1.EnvVar, name string) int {
+	for i, ev := range env {
+		if ev.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func envEqual(a, b corev1.EnvVar) bool {
+	aj, _ := json.Marshal(a)
+	bj, _ := json.Marshal(b)
+	return string(aj) == string(bj)
+}
+
+// envFromRef identifica uma fonte de envFrom como "configmap/<name>" ou "secret/<name>".
+func envFromRef(src corev1.EnvFromSource) string {
+	switch {
+	case src.ConfigMapRef != nil:
+		return "configmap/" + src.ConfigMapRef.Name
+	case src.SecretRef != nil:
+		return "secret/" + src.SecretRef.Name
+	}
+	return ""
+}
+
+func envFromIndex(from []corev1.EnvFromSource, ref string) int {
+	for i, src := range from {
+		if envFromRef(src) == ref {
+			return i
+		}
+	}
+	return -1
+}
+
+func setResourcesMutator(args map[string]any) (templateMutator, error) {
+	requests, err := parseResourceArg(args, "requests")
+	if err != nil {
+		return nil, err
+	}
+	limits, err := parseResourceArg(args, "limits")
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 && len(limits) == 0 {
+		return nil, fmt.Errorf("requests or limits is required")
+	}
+	container := utils.GetStringArg(args, "container", "")
+
+	return func(tmpl *corev1.PodTemplateSpec) ([]string, error) {
+		ctrs, err := selectContainers(tmpl, container)
+		if err != nil {
+			return nil, err
+		}
+
+		var changes []string
+		for _, ctr := range ctrs {
+			changes = append(changes, applyResourceList(ctr.Name, "requests", &ctr.Resources.Requests, requests)...)
+			changes = append(changes, applyResourceList(ctr.Name, "limits", &ctr.Resources.Limits, limits)...)
+
+			for res, limit := range ctr.Resources.Limits {
+				if req, ok := ctr.Resources.Requests[res]; ok && req.Cmp(limit) > 0 {
+					return nil, fmt.Errorf("container %s: %s request %s is greater than limit %s", ctr.Name, res, req.String(), limit.String())
+				}
+			}
+		}
+		return changes, nil
+	}, nil
+}
+
+// parseResourceArg lê um objeto recurso -> quantidade; nil marca remoção (valor vazio).
+func parseResourceArg(args map[string]any, key string) (map[corev1.ResourceName]*resource.Quantity, error) {
+	m, ok := args[key].(map[string]any)
+	if !ok {
+		if args[key] != nil {
+			return nil, fmt.Errorf("%s must be an object (resource -> quantity)", key)
+		}
+		return nil, nil
+	}
+
+	out := map[corev1.ResourceName]*resource.Quantity{}
+	for name, v := range m {
+		var s string
+		switch val := v.(type) {
+		case string:
+			s = val
+		case float64:
+			s = fmt.Sprint(val)
+		default:
+			return nil, fmt.Errorf("%s.%s must be a quantity string", key, name)
+		}
+		if s == "" {
+			out[corev1.ResourceName(name)] = nil
+			continue
+		}
+		q, err := resource.ParseQuantity(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s.%s %q: %w", key, name, s, err)
+		}
+		out[corev1.ResourceName(name)] = &q
+	}
+	return out, nil
+}
+
+func applyResourceList(container, field string, list *corev1.ResourceList, values map[corev1.ResourceName]*resource.Quantity) []string {
+	var changes []string
+	for name, q := range values {
+		current, exists := (*list)[name]
+		switch {
+		case q == nil && exists:
+			delete(*list, name)
+			changes = append(changes, fmt.Sprintf("%s: removed %s.%s", container, field, name))
+		case q != nil && (!exists || current.Cmp(*q) != 0):
+			if *list == nil {
+				*list = corev1.ResourceList{}
+			}
+			(*list)[name] = *q
+			changes = append(changes, fmt.Sprintf("%s: %s.%s=%s", container, field, name, q.String()))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
@@ -0,0 +1,117 @@
+package workloads
+
+import (
+	"github.com/fmendonca/openshift-mcp/internal/clients"
+	"github.com/fmendonca/openshift-mcp/internal/server"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func RegisterTools(srv *server.MCPServer, clients *clients.Clients) {
+	srv.AddTool(&mcp.Tool{
+		Name:        "set_image",
+		Description: "Set container images on a Deployment, StatefulSet, DaemonSet or DeploymentConfig and return the diff",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: workloadProperties(map[string]interface{}{
+				"images": map[string]interface{}{
+					"type":        "object",
+					"description": "Container name -> image (\"*\" for every container)",
+				},
+				"container": map[string]interface{}{
+					"type":        "string",
+					"description": "Container to update when using image (default every container)",
+				},
+				"image": map[string]interface{}{
+					"type":        "string",
+					"description": "Image for container (alternative to images)",
+				},
+			}),
+			Required: []string{"kind", "name", "namespace"},
+		},
+	}, newSetImageHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "set_env",
+		Description: "Set or remove environment variables on the containers of a Deployment, StatefulSet, DaemonSet or DeploymentConfig and return the diff",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: workloadProperties(map[string]interface{}{
+				"container": map[string]interface{}{
+					"type":        "string",
+					"description": "Container to update (default every container)",
+				},
+				"env": map[string]interface{}{
+					"type":        "object",
+					"description": "Name -> value string, or {configMapKeyRef:{name,key}} / {secretKeyRef:{name,key}} / {fieldRef:{fieldPath}} / {resourceFieldRef:{resource}}",
+				},
+				"fromConfigMap": map[string]interface{}{
+					"type":        "string",
+					"description": "ConfigMap to add as envFrom",
+				},
+				"fromSecret": map[string]interface{}{
+					"type":        "string",
+					"description": "Secret to add as envFrom",
+				},
+				"prefix": map[string]interface{}{
+					"type":        "string",
+					"description": "Prefix for the envFrom variables",
+				},
+				"remove": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Variable names to remove",
+				},
+				"removeFrom": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "envFrom sources to remove, as configmap/<name> or secret/<name>",
+				},
+			}),
+			Required: []string{"kind", "name", "namespace"},
+		},
+	}, newSetEnvHandler(clients))
+
+	srv.AddTool(&mcp.Tool{
+		Name:        "set_resources",
+		Description: "Set resource requests and limits on the containers of a Deployment, StatefulSet, DaemonSet or DeploymentConfig and return the diff",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: workloadProperties(map[string]interface{}{
+				"container": map[string]interface{}{
+					"type":        "string",
+					"description": "Container to update (default every container)",
+				},
+				"requests": map[string]interface{}{
+					"type":        "object",
+					"description": "Resource -> quantity, e.g. {\"cpu\":\"100m\",\"memory\":\"256Mi\"}; an empty value removes it",
+				},
+				"limits": map[string]interface{}{
+					"type":        "object",
+					"description": "Resource -> quantity; an empty value removes it",
+				},
+			}),
+			Required: []string{"kind", "name", "namespace"},
+		},
+	}, newSetResourcesHandler(clients))
+}
+
+// workloadProperties acrescenta os argumentos comuns aos três tools (workload alvo e dryRun).
+func workloadProperties(props map[string]interface{}) map[string]interface{} {
+	props["kind"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Deployment, StatefulSet, DaemonSet or DeploymentConfig (short names accepted)",
+	}
+	props["name"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Name of the workload",
+	}
+	props["namespace"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Namespace of the workload",
+	}
+	props["dryRun"] = map[string]interface{}{
+		"type":        "string",
+		"description": "\"server\" to validate on the server without persisting",
+	}
+	return props
+}
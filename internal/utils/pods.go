@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodPhase resume o estado do pod como o kubectl get pods: Terminating, o reason de um
+// container em Waiting (CrashLoopBackOff, ImagePullBackOff...) ou a fase.
+func PodPhase(pod *corev1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	for _, st := range pod.Status.ContainerStatuses {
+		if st.State.Waiting != nil && st.State.Waiting.Reason != "" {
+			return st.State.Waiting.Reason
+		}
+	}
+	return string(pod.Status.Phase)
+}
+
+// PodReadyCount devolve "prontos/total" dos containers do pod.
+func PodReadyCount(pod *corev1.Pod) string {
+	ready := 0
+	for _, st := range pod.Status.ContainerStatuses {
+		if st.Ready {
+			ready++
+		}
+	}
+	return fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers))
+}
+
+// PodRestartCount soma os restarts de todos os containers do pod.
+func PodRestartCount(pod *corev1.Pod) int32 {
+	var n int32
+	for _, st := range pod.Status.ContainerStatuses {
+		n += st.RestartCount
+	}
+	return n
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"context"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpsrv "github.com/mark3labs/mcp-go/server"
+)
+
+// ProgressReporter devolve um callback que envia cada mensagem ao cliente como
+// notifications/progress. Sem progressToken na requisição, o callback não faz nada.
+func ProgressReporter(ctx context.Context, req mcp.CallToolRequest) func(string) {
+	srv := mcpsrv.ServerFromContext(ctx)
+	if req.Params.Meta == nil || req.Params.Meta.ProgressToken == nil || srv == nil {
+		return func(string) {}
+	}
+
+	token := req.Params.Meta.ProgressToken
+	sent := 0
+	return func(msg string) {
+		sent++
+		n := mcp.NewProgressNotification(token, float64(sent), nil, &msg)
+		err := srv.SendNotificationToClient(ctx, n.Method, map[string]any{
+			"progressToken": n.Params.ProgressToken,
+			"progress":      n.Params.Progress,
+			"message":       n.Params.Message,
+		})
+		if err != nil && sent == 1 {
+			log.Printf("Failed to send progress notification: %v", err)
+		}
+	}
+}